@@ -0,0 +1,47 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// annotations returns the decoded values of a fixed field (006, 007, or 008),
+// one per line, to display along with the field in the mrk output.
+// Returns an empty string for fields that are not decoded.
+func annotations(r marc.Record, field marc.Field, newLine string) string {
+	var elements []marc.FixedElement
+	switch field.Tag {
+	case "008":
+		if r.Leader.IsAuthority() {
+			f008, _ := marc.NewAuthorityField008(field.Value)
+			elements = f008.Elements
+			break
+		}
+		f008, _ := marc.NewField008(field.Value, r.Leader)
+		if f008.Type != "" {
+			elements = append(elements, marc.FixedElement{Name: "Material", FixedValue: marc.FixedValue{Code: f008.Type}})
+		}
+		elements = append(elements, f008.Elements...)
+	case "006":
+		f006, _ := marc.NewField006(field.Value)
+		elements = f006.Elements
+	case "007":
+		f007, _ := marc.NewField007(field.Value)
+		elements = f007.Elements
+	}
+
+	str := ""
+	for _, element := range elements {
+		if element.Code == "" {
+			// not present in the field (e.g. an abbreviated 007)
+			continue
+		}
+		if element.Position == "" {
+			str += fmt.Sprintf("      %s: %s%s", element.Name, element.Code, newLine)
+		} else {
+			str += fmt.Sprintf("      %s%s", element, newLine)
+		}
+	}
+	return str
+}
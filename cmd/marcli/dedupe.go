@@ -0,0 +1,110 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// Reports the clusters of duplicate records in the file, i.e. records
+// that share a match key (see matchKeys), along with the record in each
+// cluster that survives according to the survivor rule. Use format
+// "dedupe" for a text report or "dedupe-json" for a JSON report.
+func toDedupe(params ProcessFileParams) error {
+	if count == 0 {
+		return nil
+	}
+
+	deduper, err := buildDeduper(params)
+	if err != nil {
+		return err
+	}
+
+	asJson := params.format == "dedupe-json"
+	if asJson {
+		fmt.Fprintf(params.output, "[")
+	}
+	for i, cluster := range deduper.Clusters() {
+		if i == count {
+			break
+		}
+		if asJson {
+			if i > 0 {
+				fmt.Fprintf(params.output, ",")
+			}
+			b, err := json.Marshal(cluster)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(params.output, "%s%s", params.NewLine(), b)
+		} else {
+			fmt.Fprintf(params.output, "cluster %d: %s%s", i+1, strings.Join(cluster.Keys, " "), params.NewLine())
+			fmt.Fprintf(params.output, "* %s%s", dedupeRecordString(cluster.Survivor), params.NewLine())
+			for _, dup := range cluster.Duplicates {
+				fmt.Fprintf(params.output, "  %s%s", dedupeRecordString(dup), params.NewLine())
+			}
+		}
+	}
+	if asJson {
+		fmt.Fprintf(params.output, "%s]%s", params.NewLine(), params.NewLine())
+	}
+	return nil
+}
+
+func dedupeRecordString(rec marc.DedupeRecord) string {
+	return fmt.Sprintf("record %d (%s) encoding level '%s', %d fields, 005 %s", rec.Position, rec.ControlNum, rec.EncodingLevel, rec.Fields, rec.Latest)
+}
+
+// findDuplicates returns the position of the records that are duplicates
+// of another record and do not survive, so that they can be skipped when
+// the dedupe parameter is used.
+func findDuplicates(params ProcessFileParams) (map[int]bool, error) {
+	deduper, err := buildDeduper(params)
+	if err != nil {
+		return nil, err
+	}
+	duplicates := map[int]bool{}
+	for _, cluster := range deduper.Clusters() {
+		for _, dup := range cluster.Duplicates {
+			duplicates[dup.Position] = true
+		}
+	}
+	return duplicates, nil
+}
+
+// buildDeduper reads the records in the file that match the search
+// criteria and clusters them by their match keys. The count parameter is
+// not used here since it applies to the output.
+func buildDeduper(params ProcessFileParams) (*marc.Deduper, error) {
+	if params.filename == "-" && params.sortedFile == "" {
+		return nil, errors.New("dedupe is not supported for stdin")
+	}
+
+	deduper := marc.NewDeduper(params.survivor)
+
+	format := func(r marc.Record) (interface{}, error) {
+		return marc.NewDedupeRecord(r, params.matchKeys), nil
+	}
+
+	write := func(res recordResult) error {
+		if res.err != nil {
+			if params.debug {
+				return nil
+			}
+			return res.err
+		}
+		if res.matched {
+			rec := res.value.(marc.DedupeRecord)
+			rec.Position = res.position
+			deduper.Add(rec)
+		}
+		return nil
+	}
+
+	params.duplicates = nil
+	err := processRecords(params, format, write)
+	return deduper, err
+}
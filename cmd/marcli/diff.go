@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// Compares the records in the file with the ones in the compareTo file,
+// paired by the key parameter, and reports the records added, removed,
+// and changed. Use format "diff" for a unified diff or "diff-json" for
+// a JSON report that also includes the changes in the subfields.
+func toDiff(params ProcessFileParams) error {
+	if params.compareTo == "" {
+		return errors.New("compareTo parameter is required for this format")
+	}
+	if len(params.mergeFiles) > 0 {
+		return errors.New("diff is not supported for several files")
+	}
+	if params.filename == "-" || params.compareTo == "-" {
+		return errors.New("diff is not supported for stdin")
+	}
+
+	oldFile, err := os.Open(params.filename)
+	if err != nil {
+		return err
+	}
+	defer oldFile.Close()
+
+	newFile, err := os.Open(params.compareTo)
+	if err != nil {
+		return err
+	}
+	defer newFile.Close()
+
+	asJson := params.format == "diff-json"
+	var total int
+	report := func(diff marc.RecordDiff) error {
+		if asJson {
+			if total > 0 {
+				fmt.Fprintf(params.output, ",")
+			}
+			b, err := json.Marshal(diff)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(params.output, "%s%s", params.NewLine(), b)
+		} else {
+			fmt.Fprintf(params.output, "@@ %s %s @@%s", diff.Change, diff.Key, params.NewLine())
+			for _, field := range diff.Fields {
+				if field.Old != "" {
+					fmt.Fprintf(params.output, "-%s%s", field.Old, params.NewLine())
+				}
+				if field.New != "" {
+					fmt.Fprintf(params.output, "+%s%s", field.New, params.NewLine())
+				}
+			}
+		}
+		total += 1
+		return nil
+	}
+
+	if asJson {
+		fmt.Fprintf(params.output, "[")
+	} else {
+		fmt.Fprintf(params.output, "--- %s%s+++ %s%s", params.filename, params.NewLine(), params.compareTo, params.NewLine())
+	}
+	err = marc.DiffFiles(oldFile, newFile, params.key, report)
+	if asJson {
+		fmt.Fprintf(params.output, "%s]%s", params.NewLine(), params.NewLine())
+	}
+	return err
+}
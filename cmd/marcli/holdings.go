@@ -0,0 +1,54 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/hectorcorrea/marcli/pkg/holdings"
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// Outputs the holdings statements of MFHD records, one per line, with
+// the holdings 001, the bibliographic record 004, the type of statement,
+// and the statement (tab delimited). Other records are skipped.
+func toHoldings(params ProcessFileParams) error {
+	if params.HasFilters() {
+		return errors.New("filters not supported for this format")
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	format := func(r marc.Record) (interface{}, error) {
+		if !r.Leader.IsHoldings() {
+			return nil, nil
+		}
+		return holdings.NewHoldings(r)
+	}
+
+	var out int
+	write := func(res recordResult) error {
+		if res.err != nil {
+			if params.debug {
+				return nil
+			}
+			return res.err
+		}
+		if res.matched && res.value != nil {
+			if res.formatErr != nil {
+				return res.formatErr
+			}
+			h := res.value.(holdings.Holdings)
+			for _, statement := range h.Statements {
+				fmt.Fprintf(params.output, "%s\t%s\t%s\t%s%s", h.ControlNum, h.BibControlNum, statement.Type, statement, params.NewLine())
+			}
+			if out++; out == count {
+				return marc.ErrStopPipeline
+			}
+		}
+		return nil
+	}
+
+	return processRecords(params, format, write)
+}
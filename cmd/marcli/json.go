@@ -2,10 +2,7 @@ package main
 
 import (
 	"encoding/json"
-	"errors"
 	"fmt"
-	"io"
-	"os"
 
 	"github.com/hectorcorrea/marcli/pkg/marc"
 )
@@ -13,52 +10,47 @@ import (
 // TODO: Add support for JSONL (JSON line delimited) format that makes JSON
 // easier to parse with Unix tools like grep, tail, and so on.
 func toJson(params ProcessFileParams) error {
-	if params.HasFilters() {
-		return errors.New("filters not supported for this format")
-	}
-
 	if count == 0 {
 		return nil
 	}
 
-	file, err := os.Open(params.filename)
-	if err != nil {
-		return err
+	format := func(r marc.Record) (interface{}, error) {
+		var fields []marc.Field
+		if params.pair880 {
+			fields = r.PairFilteredFields(params.filters, params.exclude)
+		} else {
+			fields = r.Filter(params.filters, params.exclude)
+		}
+		return json.Marshal(fields)
 	}
-	defer file.Close()
-
-	var i, out int
-	marc := marc.NewMarcFile(file)
 
-	fmt.Printf("[")
-	for marc.Scan() {
-		r, err := marc.Record()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return err
-		}
-		if i++; i < start {
-			continue
+	var out int
+	write := func(res recordResult) error {
+		if res.err != nil {
+			if params.debug {
+				return nil
+			}
+			return res.err
 		}
-		if r.Contains(params.searchValue, params.searchRegEx, params.searchFields) && r.HasFields(params.hasFields) {
+		if res.matched {
 			if out > 0 {
-				fmt.Printf(",%s", params.NewLine())
+				fmt.Fprintf(params.output, ",%s", params.NewLine())
 			} else {
-				fmt.Printf("%s", params.NewLine())
+				fmt.Fprintf(params.output, "%s", params.NewLine())
 			}
-			b, err := json.Marshal(r.Filter(params.filters, params.exclude))
-			if err != nil {
-				fmt.Printf("%s%s", err, params.NewLine())
+			if res.formatErr != nil {
+				fmt.Fprintf(params.output, "%s%s", res.formatErr, params.NewLine())
 			}
-			fmt.Printf("%s", b)
+			fmt.Fprintf(params.output, "%s", res.value)
 			if out++; out == count {
-				break
+				return marc.ErrStopPipeline
 			}
 		}
+		return nil
 	}
-	fmt.Printf("%s]%s", params.NewLine(), params.NewLine())
 
-	return marc.Err()
+	fmt.Fprintf(params.output, "[")
+	err := processRecords(params, format, write)
+	fmt.Fprintf(params.output, "%s]%s", params.NewLine(), params.NewLine())
+	return err
 }
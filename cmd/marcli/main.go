@@ -1,30 +1,58 @@
 package main
 
 import (
+	"compress/gzip"
 	"errors"
 	"flag"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 	"strings"
 
 	"github.com/hectorcorrea/marcli/pkg/marc"
 )
 
-var fileName, search, searchRegEx, searchFields, fields, exclude, format, hasFields, newLine string
-var start, count int
-var debug bool
+var fileName, merge, search, searchRegEx, searchFields, fields, exclude, format, hasFields, lacksFields, fieldsMode, query, leaderValues, values008, compareTo, key, matchKeys, survivor, sortKey, sortType, sortOrder, newLine string
+var mergeFiles []string
+var start, count, workers, splitRecords, splitSize int
+var debug, gzipOutput, toUTF8, toMarc8, marc8Strict, pair880, buildIndex, dedupe bool
 
 func init() {
-	flag.StringVar(&fileName, "file", "", "MARC file to process. Required.")
+	flag.StringVar(&fileName, "file", "", "MARC file to process, use - to read from stdin. Required.")
+	flag.StringVar(&merge, "merge", "", "Comma delimited list of MARC files to process after the file indicated in the file parameter, as if they were merged into a single file.")
 	flag.StringVar(&search, "match", "", "String that must be present in the content of the record, case insensitive.")
 	flag.StringVar(&searchRegEx, "matchRegEx", "", "A regular expression to match the record.")
 	flag.StringVar(&searchFields, "matchFields", "", "Comma delimited list of fields to search, used when match parameter is indicated, defaults to all fields.")
+	flag.StringVar(&leaderValues, "leader", "", "Space delimited list of leader positions and the values they must have, e.g. '07=s 17=#,8'. See notes below.")
+	flag.StringVar(&values008, "008", "", "Space delimited list of 008 positions and the values they must have, e.g. '07-10=1900-1950 35-37=eng,spa'. See notes below.")
+	flag.StringVar(&query, "query", "", "Query to select records, e.g. '245a ~ \"^history\" AND (650_0 OR 651) AND NOT 856u:*'. See notes below.")
 	flag.StringVar(&fields, "fields", "", "Comma delimited list of fields to output.")
 	flag.StringVar(&exclude, "exclude", "", "Comma delimited list of fields to exclude from the output.")
-	flag.StringVar(&format, "format", "mrk", "Output format. Accepted values: mrk, mrc, xml, json, solr, yaz, or count-only.")
+	flag.StringVar(&format, "format", "mrk", "Output format. Accepted values: mrk, annotated, mrc, xml, json, solr, yaz, count-only, holdings, validate, validate-json, diff, diff-json, dedupe, dedupe-json, stats, or stats-json.")
 	flag.IntVar(&start, "start", 1, "Number of first record to load.")
 	flag.IntVar(&count, "count", -1, "Total number of records to load (-1 no limit).")
+	flag.IntVar(&workers, "workers", 1, "Number of goroutines used to parse, match, and format the records. The output is always in the same order as the records in the file.")
 	flag.StringVar(&hasFields, "hasFields", "", "Comma delimited list of fields that must be present in the record.")
+	flag.StringVar(&lacksFields, "lacksFields", "", "Comma delimited list of fields that must be missing from the record.")
+	flag.StringVar(&fieldsMode, "fieldsMode", "any", "Indicates whether any or all of the fields in hasFields and lacksFields must be present (or missing). Valid values any or all.")
 	flag.BoolVar(&debug, "debug", false, "When true it does not stop on errors.")
+	flag.BoolVar(&gzipOutput, "gzip", false, "When true the output is compressed with gzip. Supported for mrc, xml, and json formats.")
+	flag.BoolVar(&toUTF8, "toUTF8", false, "When true records encoded in MARC-8 (leader/09 blank) are converted to UTF-8. CJK ideographs, Hangul, and Extended Arabic are not supported.")
+	flag.BoolVar(&toMarc8, "toMarc8", false, "When true records are converted to MARC-8. Only supported for mrc format.")
+	flag.BoolVar(&marc8Strict, "marc8Strict", false, "When true the conversion to MARC-8 fails on characters that cannot be represented, otherwise they are replaced with a numeric character reference (&#xXXXX;).")
+	flag.BoolVar(&pair880, "pair880", false, "When true the 880 fields (vernacular) are output next to the field they are linked to. Supported for mrk, annotated, and json formats.")
+	flag.BoolVar(&buildIndex, "buildIndex", false, "When true it builds an index of the records in the file (saved as file.idx) that is used to speed up the start parameter. Only supported for uncompressed MARC binary files.")
+	flag.StringVar(&compareTo, "compareTo", "", "MARC file to compare with the file indicated in the file parameter. Used with the diff and diff-json formats.")
+	flag.StringVar(&key, "key", "001", "Field used to pair the records (e.g. 001 or 035a) in the diff and diff-json formats.")
+	flag.StringVar(&matchKeys, "matchKeys", "isbn,issn,oclc,lccn,title", "Comma delimited list of keys used to find duplicate records. Valid values isbn, issn, oclc, lccn, and title. See notes below.")
+	flag.StringVar(&survivor, "survivor", "encoding,fields,latest", "Comma delimited list of criteria, in order, to decide which record in a cluster of duplicates survives. Valid values encoding, fields, and latest. See notes below.")
+	flag.BoolVar(&dedupe, "dedupe", false, "When true duplicate records (see matchKeys) are not output, only the record that survives in each cluster of duplicates.")
+	flag.IntVar(&splitRecords, "splitRecords", 0, "Maximum number of records per file when splitting the file (0 no limit). Only supported for mrc format. See notes below.")
+	flag.IntVar(&splitSize, "splitSize", 0, "Maximum size in megabytes per file when splitting the file (0 no limit). Only supported for mrc format. See notes below.")
+	flag.StringVar(&sortKey, "sort", "", "Field used to sort the records, e.g. 001, 050ab, 245a, LDR/06-07, or 008/07-10. See notes below.")
+	flag.StringVar(&sortType, "sortType", "string", "Indicates how to compare the values of the sort field. Valid values string or numeric.")
+	flag.StringVar(&sortOrder, "sortOrder", "asc", "Sort order. Valid values asc or desc.")
 	flag.StringVar(&newLine, "newLine", "LF", "Character(s) to use to indicate new lines. Valid values LF or CRLF.")
 	flag.Parse()
 }
@@ -35,31 +63,127 @@ func main() {
 		return
 	}
 
+	if flag.NArg() > 0 {
+		panic(fmt.Sprintf("Unexpected argument %s, use the merge parameter to process several files.", flag.Arg(0)))
+	}
+	if merge != "" {
+		for _, filename := range strings.Split(merge, ",") {
+			mergeFiles = append(mergeFiles, strings.TrimSpace(filename))
+		}
+	}
+
+	if buildIndex {
+		for _, filename := range append([]string{fileName}, mergeFiles...) {
+			if err := writeIndex(filename); err != nil {
+				panic(err)
+			}
+		}
+		return
+	}
+
 	params := ProcessFileParams{
 		filename:     fileName,
+		mergeFiles:   mergeFiles,
 		format:       format,
 		searchValue:  strings.ToLower(search),
 		searchRegEx:  searchRegEx,
 		searchFields: searchFieldsFromString(searchFields),
-		filters:      marc.NewFieldFilters(fields),
-		exclude:      marc.NewFieldFilters(exclude),
+		filters:      parseFieldFilters(fields),
+		exclude:      parseFieldFilters(exclude),
 		start:        start,
 		count:        count,
-		hasFields:    marc.NewFieldFilters(hasFields),
+		hasFields:    parseFieldFilters(hasFields),
+		lacksFields:  parseFieldFilters(lacksFields),
+		allFields:    fieldsMode == "all",
 		debug:        debug,
 		newLine:      newLine,
+		output:       os.Stdout,
+		toUTF8:       toUTF8,
+		toMarc8:      toMarc8,
+		marc8Strict:  marc8Strict,
+		pair880:      pair880,
+		workers:      workers,
+		compareTo:    compareTo,
+		key:          key,
+		matchKeys:    parseMatchKeys(matchKeys),
+		survivor:     parseSurvivorRule(survivor),
+		splitRecords: splitRecords,
+		splitSize:    int64(splitSize) * 1024 * 1024,
+	}
+
+	params.positions = append(parsePositionFilters("LDR", leaderValues), parsePositionFilters("008", values008)...)
+
+	if query != "" {
+		q, err := marc.ParseQuery(query)
+		if err != nil {
+			panic(err)
+		}
+		params.query = q
 	}
 
 	if len(params.filters.Fields) > 0 && len(params.exclude.Fields) > 0 {
 		panic("Cannot specify fields and exclude at the same time.")
 	}
 
+	if fieldsMode != "any" && fieldsMode != "all" {
+		panic("Invalid fieldsMode, valid values are any or all.")
+	}
+
 	if params.searchValue != "" && params.searchRegEx != "" {
 		panic("Cannot specify match and matchRegEx at the same time.")
 	}
 
+	if params.toMarc8 && format != "mrc" {
+		panic("toMarc8 is only supported for mrc format.")
+	}
+
+	if splitRecords != 0 || splitSize != 0 {
+		if format != "mrc" || gzipOutput {
+			panic("splitRecords and splitSize are only supported for mrc format without gzip.")
+		}
+		if fileName == "-" {
+			panic("splitRecords and splitSize are not supported for stdin.")
+		}
+	}
+
+	if sortKey != "" {
+		if sortType != "string" && sortType != "numeric" {
+			panic("Invalid sortType, valid values are string or numeric.")
+		}
+		if sortOrder != "asc" && sortOrder != "desc" {
+			panic("Invalid sortOrder, valid values are asc or desc.")
+		}
+		key, err := marc.NewSortKey(sortKey, sortType == "numeric", sortOrder == "desc")
+		if err != nil {
+			panic(fmt.Errorf("%s: %w", sortKey, err))
+		}
+		sortedFile, err := sortRecords(params, key)
+		if err != nil {
+			panic(err)
+		}
+		defer os.Remove(sortedFile)
+		params.sortedFile = sortedFile
+	}
+
+	if dedupe {
+		duplicates, err := findDuplicates(params)
+		if err != nil {
+			panic(err)
+		}
+		params.duplicates = duplicates
+	}
+
+	var gz *gzip.Writer
+	if gzipOutput {
+		if format != "mrc" && format != "xml" && format != "json" {
+			panic("gzip output not supported for this format.")
+		}
+		gz = gzip.NewWriter(os.Stdout)
+		params.output = gz
+	}
+
 	var err error
-	if format == "mrk" || format == "count-only" {
+	if format == "mrk" || format == "annotated" || format == "count-only" {
 		err = toMrk(params)
 	} else if format == "mrc" {
 		err = toMrc(params)
@@ -71,9 +195,24 @@ func main() {
 		err = toXML(params)
 	} else if format == "yaz" {
 		err = toYaz(params)
+	} else if format == "holdings" {
+		err = toHoldings(params)
+	} else if format == "validate" || format == "validate-json" {
+		err = toValidate(params)
+	} else if format == "diff" || format == "diff-json" {
+		err = toDiff(params)
+	} else if format == "dedupe" || format == "dedupe-json" {
+		err = toDedupe(params)
+	} else if format == "stats" || format == "stats-json" {
+		err = toStats(params)
 	} else {
 		err = errors.New("invalid format")
 	}
+	if gz != nil {
+		if closeErr := gz.Close(); err == nil {
+			err = closeErr
+		}
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -88,27 +227,216 @@ func showSyntax() {
 NOTES:
 	The match parameter is used to filter records based on their content.
 By default marcli searches in all the fields for each record, you can use
-the matchFields parameter to limit the search to only certain fields (subfields
-are not supported in matchFields, i.e. 245 is OK, 245a is not)
+the matchFields parameter to limit the search to only certain fields or
+subfields (e.g. 245a)
 
     The matchRegEx parameter can be used to filter records based on a regular expression
 (e.g. '.*03-\d\d-06.*' to get records with dates from March 2006)
 
     The hasFields parameter is used to filter records based on the presence
-of certain fields on the record (regardless of their value).
+of certain fields on the record (regardless of their value), and the
+lacksFields parameter on their absence. By default a record must have any
+of the fields in hasFields and be missing any of the fields in lacksFields,
+use fieldsMode all to require all of them instead.
+
+	The fields, exclude, hasFields, and matchFields parameters accept tags with
+wildcards (e.g. 6XX or 1..), indicators after the tag (_ for any, # for blank,
+e.g. 650_0 for the 650 fields with second indicator 0, two digits without _
+or # are subfields, use @ before two digit indicators as in 245@10),
+subfields (e.g. 245ab), and character positions in control fields (e.g.
+008/35-37).
 
 	You can only use the fields or exclude parameter, but not both.
+
+    The leader and 008 parameters select records by the values in certain
+positions (zero based) of the leader or the 008. Each position (or range of
+positions) is followed by the list of values allowed, ranges of values are
+supported too, and # indicates blank. For example -leader '07=s' outputs only
+serials, -leader '05=d' only deleted records, and -008 '07-10=1900-1950'
+only records with Date1 between 1900 and 1950.
+
+    The query parameter selects records with a boolean expression of tests
+combined with AND, OR, NOT, and parentheses. Each test indicates a field,
+optionally with indicators (_ for any, # for blank), subfields, or positions
+in the leader and control fields, and an operator: none (or :*) for exists,
+: for contains (case insensitive), ~ for regular expression, and = for equal.
+For example: 650_0a:coal AND LDR/07 = m AND NOT 008/35-37 = eng
+
+    Several files can be processed as if they were a single file by
+indicating the other files in the merge parameter, e.g. -file one.mrc -merge
+two.xml,three.mrc. The files can be in any combination of MARC binary and
+MARC XML.
+
+    The splitRecords and splitSize parameters split the file into several
+files with up to splitRecords records and/or up to splitSize megabytes each.
+Records are never split across files. The files are named after the file
+parameter with a sequence number and the .mrc extension, e.g. load_0001.mrc,
+load_0002.mrc, and so on for load.mrc or load.xml. Existing files are never
+overwritten.
+
+    The sort parameter sorts the records by the value of a field or
+subfields (e.g. 001, 050ab, or 245a, the nonfiling characters of title fields
+are skipped) or by positions in the leader or a control field (e.g. 008/07-10).
+Values are compared as strings (case insensitive) unless sortType is numeric,
+in which case the first number in each value is used. Records without the
+field sort first. Files larger than memory are sorted with temporary files
+and MARC binary records are output unchanged.
+
+    The dedupe and dedupe-json formats report the clusters of duplicate
+records, i.e. records that share any of the keys in the matchKeys parameter:
+isbn (020a normalized to ISBN-13), issn (022a), oclc (035a with the (OCoLC)
+prefix or the 001 of OCLC records), lccn (010a normalized), and title (245abnp,
+Date1 in 008/07-10, and the first word of the main entry). The record that
+survives in each cluster is the one with the highest encoding level, then the
+one with most fields, then the one with the newest 005; use the survivor
+parameter to change these criteria or their order. Use the dedupe parameter
+to output the records without their duplicates in any format.
 `)
 	fmt.Println()
 	fmt.Println()
 }
 
+// parseFieldFilters parses the value of a parameter with a list of fields
+// and stops if it is not valid (rather than ignoring it).
+func parseFieldFilters(fieldsStr string) marc.FieldFilters {
+	filters, err := marc.ParseFieldFilters(fieldsStr)
+	if err != nil {
+		panic(err)
+	}
+	return filters
+}
+
+// parseMatchKeys parses the value of the matchKeys parameter and stops
+// if it is not valid.
+func parseMatchKeys(str string) []string {
+	keys, err := marc.ParseMatchKeys(str)
+	if err != nil {
+		panic(err)
+	}
+	return keys
+}
+
+// parseSurvivorRule parses the value of the survivor parameter and stops
+// if it is not valid.
+func parseSurvivorRule(str string) marc.SurvivorRule {
+	rule, err := marc.ParseSurvivorRule(str)
+	if err != nil {
+		panic(err)
+	}
+	return rule
+}
+
+// parsePositionFilters parses the value of the leader and 008 parameters,
+// e.g. "07=s 17=#,8" into position filters for the given tag.
+func parsePositionFilters(tag string, str string) []marc.PositionFilter {
+	values := []string{}
+	for _, value := range strings.Fields(str) {
+		values = append(values, tag+"/"+value)
+	}
+	filters, err := marc.ParsePositionFilters(strings.Join(values, " "))
+	if err != nil {
+		panic(fmt.Errorf("%s: %w", str, err))
+	}
+	return filters
+}
+
 func searchFieldsFromString(searchFieldsString string) []string {
 	values := []string{}
 	for _, value := range strings.Split(searchFieldsString, ",") {
 		if strings.TrimSpace(searchFieldsString) != "" {
+			if _, err := marc.NewFieldFilter(value); err != nil {
+				panic(fmt.Errorf("%s: %w", value, err))
+			}
 			values = append(values, value)
 		}
 	}
 	return values
 }
+
+// writeIndex builds the index for a MARC file and saves it next to the
+// file, the 001 of each record is used as its key. The size and the
+// modification time of the file are saved too so that indexes that are
+// out of date are ignored.
+func writeIndex(filename string) error {
+	if filename == "-" {
+		return errors.New("cannot build an index for stdin")
+	}
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	index, err := marc.BuildIndex(file, "001")
+	if err != nil {
+		return err
+	}
+	index.ModTime = info.ModTime().UnixNano()
+
+	indexFile, err := os.Create(marc.IndexFilename(filename))
+	if err != nil {
+		return err
+	}
+	defer indexFile.Close()
+	if err := index.Write(indexFile); err != nil {
+		return err
+	}
+	fmt.Printf("Indexed %d records in %s\n", len(index.Entries), marc.IndexFilename(filename))
+	return nil
+}
+
+// newMarcFile creates the MarcFile to read the records from. When the
+// file has an up to date index it is used to seek directly to the start
+// record, in which case it also returns the number of records skipped.
+func newMarcFile(file io.Reader, params ProcessFileParams) (marc.MarcFile, int) {
+	skipped := 0
+	marcFile, ok := indexedMarcFile(file, params)
+	if ok && marcFile.SeekRecord(params.start-1) == nil {
+		skipped = params.start - 1
+	} else {
+		marcFile = marc.NewMarcFile(file)
+	}
+	marcFile.ConvertMarc8(params.toUTF8)
+	return marcFile, skipped
+}
+
+// indexedMarcFile returns a MarcFile that uses the index of the file, if
+// the index exists and it matches the size and modification time of the
+// file. The index is only loaded when it is useful, i.e. when the start
+// parameter is used.
+func indexedMarcFile(file io.Reader, params ProcessFileParams) (marc.MarcFile, bool) {
+	osFile, ok := file.(*os.File)
+	if !ok || params.filename == "-" || params.start <= 1 {
+		return marc.MarcFile{}, false
+	}
+
+	info, err := osFile.Stat()
+	if err != nil {
+		return marc.MarcFile{}, false
+	}
+
+	indexFile, err := os.Open(marc.IndexFilename(params.filename))
+	if err != nil {
+		return marc.MarcFile{}, false
+	}
+	defer indexFile.Close()
+
+	index, err := marc.ReadIndex(indexFile)
+	if err != nil || !index.IsCurrent(info) {
+		// Ignore indexes that are invalid or out of date.
+		return marc.MarcFile{}, false
+	}
+	return marc.NewIndexedMarcFile(osFile, index), true
+}
+
+// openFile opens the file to process. A filename of "-" represents stdin.
+func openFile(filename string) (io.ReadCloser, error) {
+	if filename == "-" {
+		return ioutil.NopCloser(os.Stdin), nil
+	}
+	return os.Open(filename)
+}
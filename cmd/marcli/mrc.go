@@ -1,50 +1,117 @@
 package main
 
 import (
-	"errors"
 	"fmt"
 	"io"
 	"os"
+	"sort"
+	"sync"
 
 	"github.com/hectorcorrea/marcli/pkg/marc"
 )
 
 func toMrc(params ProcessFileParams) error {
-	if params.HasFilters() {
-		return errors.New("filters not supported for this format")
-	}
-
 	if count == 0 {
 		return nil
 	}
 
-	file, err := os.Open(params.filename)
-	if err != nil {
-		return err
+	var encoder *marc.Marc8Encoder
+	if params.toMarc8 {
+		encoder = marc.NewMarc8Encoder(!params.marc8Strict)
 	}
-	defer file.Close()
 
-	var i, out int
-	marc := marc.NewMarcFile(file)
-	for marc.Scan() {
-		r, err := marc.Record()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return err
+	// The encoder keeps track of the characters that could not be
+	// represented so it cannot be used by several workers at once.
+	var encoderMutex sync.Mutex
+	format := func(r marc.Record) (interface{}, error) {
+		var err error
+		if encoder != nil {
+			encoderMutex.Lock()
+			r, err = encoder.EncodeRecord(r)
+			encoderMutex.Unlock()
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		if i++; i < start {
-			continue
+		// Records read from MARC XML or converted from MARC-8 have no
+		// raw data that matches their fields.
+		if params.HasFilters() || r.Modified() {
+			// Rebuild the binary data from the (filtered) fields
+			r.Fields = r.Filter(params.filters, params.exclude)
+			return r.MarshalBinary()
 		}
+		return r.Raw(), nil
+	}
 
-		if r.Contains(params.searchValue, params.searchRegEx, params.searchFields) && r.HasFields(params.hasFields) {
-			fmt.Printf("%s", r.Raw())
+	var splitter *marc.SplitWriter
+	if params.splitRecords > 0 || params.splitSize > 0 {
+		splitter = marc.NewSplitWriter(params.splitRecords, params.splitSize, func(part int) (io.WriteCloser, error) {
+			filename := marc.SplitFilename(params.filename, part)
+			// never overwrite an existing file (e.g. the parts of a
+			// previous split or the input file itself)
+			file, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+			if os.IsExist(err) {
+				return nil, fmt.Errorf("%s already exists", filename)
+			} else if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(os.Stderr, "Writing %s\n", filename)
+			return file, nil
+		})
+	}
+
+	var out int
+	write := func(res recordResult) error {
+		if res.err != nil {
+			if params.debug {
+				return nil
+			}
+			return res.err
+		}
+		if res.matched {
+			if res.formatErr != nil {
+				return fmt.Errorf("record %s: %s", res.record.ControlNum(), res.formatErr)
+			}
+			if splitter != nil {
+				if err := splitter.WriteRecord(res.value.([]byte)); err != nil {
+					return err
+				}
+			} else {
+				fmt.Fprintf(params.output, "%s", res.value)
+			}
 			if out++; out == count {
-				break
+				return marc.ErrStopPipeline
 			}
 		}
+		return nil
+	}
+
+	err := processRecords(params, format, write)
+	if splitter != nil {
+		if closeErr := splitter.Close(); err == nil {
+			err = closeErr
+		}
+	}
+	if encoder != nil {
+		reportUnmapped(encoder)
+	}
+	return err
+}
+
+// reportUnmapped prints to stderr the characters that could not be
+// represented in MARC-8 (if any).
+func reportUnmapped(encoder *marc.Marc8Encoder) {
+	if len(encoder.Unmapped) == 0 {
+		return
+	}
+	chars := []rune{}
+	for c := range encoder.Unmapped {
+		chars = append(chars, c)
+	}
+	sort.Slice(chars, func(i, j int) bool { return chars[i] < chars[j] })
+	fmt.Fprintf(os.Stderr, "Characters that could not be represented in MARC-8:\n")
+	for _, c := range chars {
+		fmt.Fprintf(os.Stderr, "\tU+%04X %q (%d)\n", c, c, encoder.Unmapped[c])
 	}
-	return marc.Err()
 }
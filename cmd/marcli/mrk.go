@@ -2,8 +2,6 @@ package main
 
 import (
 	"fmt"
-	"io"
-	"os"
 
 	"github.com/hectorcorrea/marcli/pkg/marc"
 )
@@ -15,61 +13,61 @@ func toMrk(params ProcessFileParams) error {
 		return nil
 	}
 
-	file, err := os.Open(params.filename)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	var i, out, recordCount int
-	marc := marc.NewMarcFile(file)
-	for marc.Scan() {
-
-		r, err := marc.Record()
-		if err == io.EOF {
-			break
+	format := func(r marc.Record) (interface{}, error) {
+		str := ""
+		if params.filters.IncludeLeader() {
+			str += fmt.Sprintf("%s%s", r.Leader, params.NewLine())
+		}
+		var fields []marc.Field
+		if params.pair880 {
+			fields = r.PairFilteredFields(params.filters, params.exclude)
+		} else {
+			fields = r.Filter(params.filters, params.exclude)
 		}
+		for _, field := range fields {
+			str += fmt.Sprintf("%s%s", field, params.NewLine())
+			if params.format == "annotated" {
+				str += annotations(r, field, params.NewLine())
+			}
+		}
+		return str, nil
+	}
 
-		if err != nil {
+	var out, recordCount int
+	write := func(res recordResult) error {
+		if res.err != nil {
 			str := "== RECORD WITH ERROR STARTS HERE" + params.NewLine()
-			str += "ERROR:" + params.NewLine() + err.Error() + params.NewLine()
-			str += r.DebugString() + params.NewLine()
+			str += "ERROR:" + params.NewLine() + res.err.Error() + params.NewLine()
+			str += res.record.DebugString() + params.NewLine()
 			str += "== RECORD WITH ERROR ENDS HERE" + params.NewLine() + params.NewLine()
-			fmt.Print(str)
+			fmt.Fprint(params.output, str)
 			if params.debug {
-				continue
+				return nil
 			}
-			return err
+			return res.err
 		}
 
-		if i++; i < start {
-			continue
-		}
-
-		if r.Contains(params.searchValue, params.searchRegEx, params.searchFields) && r.HasFields(params.hasFields) {
+		if res.matched {
 			recordCount += 1
-			str := ""
-			if params.filters.IncludeLeader() {
-				str += fmt.Sprintf("%s%s", r.Leader, params.NewLine())
-			}
-			for _, field := range r.Filter(params.filters, params.exclude) {
-				str += fmt.Sprintf("%s%s", field, params.NewLine())
-			}
+			str := res.value.(string)
 			if str != "" {
 				// Print the details of the record
-				if params.format == "mrk" {
-					fmt.Printf("%s%s", str, params.NewLine())
+				if params.format == "mrk" || params.format == "annotated" {
+					fmt.Fprintf(params.output, "%s%s", str, params.NewLine())
 				}
 				if out++; out == count {
-					break
+					return marc.ErrStopPipeline
 				}
 			}
 		}
+		return nil
 	}
 
+	err := processRecords(params, format, write)
+
 	// Print the count of records only
 	if params.format == "count-only" {
-		fmt.Printf("%d%s", recordCount, params.NewLine())
+		fmt.Fprintf(params.output, "%d%s", recordCount, params.NewLine())
 	}
-	return marc.Err()
+	return err
 }
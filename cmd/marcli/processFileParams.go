@@ -1,6 +1,7 @@
 package main
 
 import (
+	"io"
 	"strings"
 
 	"github.com/hectorcorrea/marcli/pkg/marc"
@@ -8,6 +9,7 @@ import (
 
 type ProcessFileParams struct {
 	filename     string
+	mergeFiles   []string // files to process after filename
 	searchValue  string
 	searchRegEx  string
 	searchFields []string
@@ -17,14 +19,49 @@ type ProcessFileParams struct {
 	start        int
 	count        int
 	hasFields    marc.FieldFilters
+	lacksFields  marc.FieldFilters
+	allFields    bool
+	positions    []marc.PositionFilter
+	query        marc.Query
 	debug        bool
 	newLine      string
+	output       io.Writer
+	toUTF8       bool
+	toMarc8      bool
+	marc8Strict  bool
+	pair880      bool
+	workers      int
+	compareTo    string
+	key          string
+	matchKeys    []string
+	survivor     marc.SurvivorRule
+	duplicates   map[int]bool // position of the records to skip with -dedupe
+	splitRecords int
+	splitSize    int64  // bytes
+	sortedFile   string // temporary file with the records sorted (-sort)
 }
 
 func (p ProcessFileParams) HasFilters() bool {
 	return len(p.filters.Fields) > 0 || len(p.exclude.Fields) > 0
 }
 
+// Matches returns true if the record matches the search criteria
+// (match, matchRegEx, hasFields, lacksFields, leader, 008, and query).
+func (p ProcessFileParams) Matches(r marc.Record) bool {
+	if !r.Contains(p.searchValue, p.searchRegEx, p.searchFields) || !p.query.Match(r) {
+		return false
+	}
+	for _, filter := range p.positions {
+		if !filter.Match(r) {
+			return false
+		}
+	}
+	if p.allFields {
+		return r.HasAllFields(p.hasFields) && (len(p.lacksFields.Fields) == 0 || r.LacksAllFields(p.lacksFields))
+	}
+	return r.HasFields(p.hasFields) && (len(p.lacksFields.Fields) == 0 || r.LacksFields(p.lacksFields))
+}
+
 func (p ProcessFileParams) NewLine() string {
 	if strings.ToUpper(newLine) == "CRLF" {
 		// Windows style
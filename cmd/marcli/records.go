@@ -0,0 +1,97 @@
+package main
+
+import (
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// recordResult is the outcome of processing one record of the file.
+type recordResult struct {
+	position  int // position of the record in the file (one based)
+	record    marc.Record
+	err       error       // error parsing the record
+	matched   bool        // true if the record matches the search criteria
+	value     interface{} // the value returned by the formatFunc
+	formatErr error       // the error returned by the formatFunc
+}
+
+// formatFunc converts a record that matches the search criteria to the
+// value to output. It is called from several goroutines at the same time
+// when the workers parameter is greater than one.
+type formatFunc func(r marc.Record) (interface{}, error)
+
+// writeFunc outputs the result of processing a record. It is called in
+// the order of the records in the file and it can return
+// marc.ErrStopPipeline to stop processing records.
+type writeFunc func(res recordResult) error
+
+// processRecords reads the records in the file, and then in the files to
+// merge (if any), starting at the record indicated in the start parameter.
+// The records are parsed, matched against the search criteria, and
+// formatted with the formatFunc by a pool of params.workers goroutines
+// but the results are written in the same order as the records in the
+// file. Positions continue from one file to the next one so that start
+// and count apply to the merged records. When the records are sorted
+// they are read from the temporary file with the sorted records instead.
+func processRecords(params ProcessFileParams, format formatFunc, write writeFunc) error {
+	filenames := append([]string{params.filename}, params.mergeFiles...)
+	if params.sortedFile != "" {
+		filenames = []string{params.sortedFile}
+	}
+
+	offset := 0
+	stopped := false
+	for _, filename := range filenames {
+		fileParams := params
+		fileParams.filename = filename
+		fileParams.start = params.start - offset
+		records, err := processFileRecords(fileParams, offset, format, func(res recordResult) error {
+			err := write(res)
+			stopped = err == marc.ErrStopPipeline
+			return err
+		})
+		if err != nil || stopped {
+			return err
+		}
+		offset += records
+	}
+	return nil
+}
+
+// processFileRecords processes the records in a single file, the
+// position of the records start after the offset. It returns the number
+// of records in the file.
+func processFileRecords(params ProcessFileParams, offset int, format formatFunc, write writeFunc) (int, error) {
+	file, err := openFile(params.filename)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+
+	marcFile, skipped := newMarcFile(file, params)
+
+	process := func(i int, r marc.Record, err error) interface{} {
+		res := recordResult{position: offset + skipped + i + 1, record: r, err: err}
+		if res.position < offset+params.start {
+			// No need to match or format records before the start.
+			return res
+		}
+		if err == nil && !params.duplicates[res.position] && params.Matches(r) {
+			res.matched = true
+			res.value, res.formatErr = format(r)
+		}
+		return res
+	}
+
+	records := skipped
+	output := func(result interface{}) error {
+		res := result.(recordResult)
+		records = res.position - offset
+		if res.position < offset+params.start {
+			return nil
+		}
+		return write(res)
+	}
+
+	err = marcFile.RunPipeline(params.workers, process, output)
+	return records, err
+}
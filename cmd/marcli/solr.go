@@ -4,8 +4,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
-	"os"
 	"strings"
 
 	"github.com/hectorcorrea/marcli/pkg/marc"
@@ -28,6 +26,27 @@ type SolrDocument struct {
 	SubjectsGeneral []string `json:"subjects_general_ss,omitempty"`
 	SubjectsChrono  []string `json:"subjects_chrono_ss,omitempty"`
 	SubjectsGeo     []string `json:"subjects_geo_ss,omitempty"`
+
+	// Vernacular values from the linked 880 fields
+	AuthorVern         string   `json:"author_vern_txt,omitempty"`
+	AuthorsOtherVern   []string `json:"authors_other_vern_txts,omitempty"`
+	TitleVern          string   `json:"title_vern_txt,omitempty"`
+	ResponsibilityVern string   `json:"responsibility_vern_txt,omitempty"`
+	PublisherPlaceVern string   `json:"publisher_place_vern_s,omitempty"`
+	PublisherNameVern  string   `json:"publisher_name_vern_s,omitempty"`
+}
+
+// AuthoritySolrDocument is the Solr document for authority records
+// (leader/06 = z).
+type AuthoritySolrDocument struct {
+	Id           string   `json:"id"`
+	Heading      string   `json:"heading_txt_en,omitempty"`
+	HeadingType  string   `json:"heading_type_s,omitempty"`
+	SeeFrom      []string `json:"see_from_txts_en,omitempty"`
+	SeeAlso      []string `json:"see_also_txts_en,omitempty"`
+	KindOfRecord string   `json:"kind_of_record_s,omitempty"`
+	Thesaurus    string   `json:"thesaurus_s,omitempty"`
+	Sources      []string `json:"sources_txts_en,omitempty"`
 }
 
 func NewSolrDocument(r marc.Record) SolrDocument {
@@ -64,6 +83,40 @@ func NewSolrDocument(r marc.Record) SolrDocument {
 	doc.SubjectsGeneral = subjects(r, "x")
 	doc.SubjectsChrono = subjects(r, "y")
 	doc.SubjectsGeo = subjects(r, "z")
+
+	doc.AuthorVern = r.GetVernacularValue("100", "a")
+	if doc.AuthorVern == "" {
+		doc.AuthorVern = r.GetVernacularValue("110", "a")
+	}
+	doc.AuthorsOtherVern = r.GetVernacularValues("700", "a")
+	doc.TitleVern = concat(r.GetVernacularValue("245", "a"), r.GetVernacularValue("245", "b"))
+	doc.ResponsibilityVern = r.GetVernacularValue("245", "c")
+	doc.PublisherPlaceVern = r.GetVernacularValue("260", "a")
+	doc.PublisherNameVern = r.GetVernacularValue("260", "b")
+	return doc
+}
+
+func NewAuthoritySolrDocument(r marc.Record) AuthoritySolrDocument {
+	doc := AuthoritySolrDocument{}
+	id := r.GetValue("001", "")
+	if id == "" {
+		id = "INVALID"
+	}
+	doc.Id = strings.TrimSpace(id)
+	if heading, err := r.Heading(); err == nil {
+		doc.Heading = trimPeriod(heading.Value)
+		doc.HeadingType = heading.Type
+	}
+	for _, heading := range r.SeeFrom() {
+		doc.SeeFrom = append(doc.SeeFrom, trimPeriod(heading.Value))
+	}
+	for _, heading := range r.SeeAlso() {
+		doc.SeeAlso = append(doc.SeeAlso, trimPeriod(heading.Value))
+	}
+	f008, _ := r.AuthorityField008()
+	doc.KindOfRecord = f008.KindOfRecord.Label
+	doc.Thesaurus = f008.SubjectHeadingSystem.Label
+	doc.Sources = r.GetValues("670", "a")
 	return doc
 }
 
@@ -76,47 +129,45 @@ func toSolr(params ProcessFileParams) error {
 		return nil
 	}
 
-	file, err := os.Open(params.filename)
-	if err != nil {
-		return err
+	format := func(r marc.Record) (interface{}, error) {
+		var doc interface{}
+		if r.Leader.Type == 'z' {
+			doc = NewAuthoritySolrDocument(r)
+		} else {
+			doc = NewSolrDocument(r)
+		}
+		return json.Marshal(doc)
 	}
-	defer file.Close()
 
-	var i, out int
-	marc := marc.NewMarcFile(file)
-
-	fmt.Printf("[")
-	for marc.Scan() {
-		r, err := marc.Record()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return err
-		}
-		if i++; i < start {
-			continue
+	var out int
+	write := func(res recordResult) error {
+		if res.err != nil {
+			if params.debug {
+				return nil
+			}
+			return res.err
 		}
-		if r.Contains(params.searchValue, params.searchRegEx, params.searchFields) && r.HasFields(params.hasFields) {
+		if res.matched {
 			if out > 0 {
-				fmt.Printf(",%s", params.NewLine())
+				fmt.Fprintf(params.output, ",%s", params.NewLine())
 			} else {
-				fmt.Printf("%s", params.NewLine())
+				fmt.Fprintf(params.output, "%s", params.NewLine())
 			}
-			doc := NewSolrDocument(r)
-			b, err := json.Marshal(doc)
-			if err != nil {
-				fmt.Printf("%s%s", err, params.NewLine())
+			if res.formatErr != nil {
+				fmt.Fprintf(params.output, "%s%s", res.formatErr, params.NewLine())
 			}
-			fmt.Printf("%s", b)
+			fmt.Fprintf(params.output, "%s", res.value)
 			if out++; out == count {
-				break
+				return marc.ErrStopPipeline
 			}
 		}
+		return nil
 	}
-	fmt.Printf("%s]%s", params.NewLine(), params.NewLine())
 
-	return marc.Err()
+	fmt.Fprintf(params.output, "[")
+	err := processRecords(params, format, write)
+	fmt.Fprintf(params.output, "%s]%s", params.NewLine(), params.NewLine())
+	return err
 }
 
 func subjects(r marc.Record, subfield string) []string {
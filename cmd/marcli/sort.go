@@ -0,0 +1,46 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// sortMemory is the maximum size of the records kept in memory while
+// sorting, larger files are sorted with temporary files.
+const sortMemory = 256 * 1024 * 1024
+
+// sortRecords sorts the records in the file, and in the files to merge,
+// by the sort key and saves them in a temporary file in MARC binary. The
+// temporary file is then processed as the input file to output the
+// records in the requested format. Records read from MARC binary are
+// saved unchanged. The caller must remove the temporary file.
+func sortRecords(params ProcessFileParams, key marc.SortKey) (string, error) {
+	sorter := marc.NewSorter(key, "", sortMemory)
+	defer sorter.Close()
+
+	for _, filename := range append([]string{params.filename}, params.mergeFiles...) {
+		file, err := openFile(filename)
+		if err != nil {
+			return "", err
+		}
+		marcFile := marc.NewMarcFile(file)
+		err = sorter.AddFile(&marcFile)
+		file.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sorted, err := ioutil.TempFile("", "marcli-sorted-*.mrc")
+	if err != nil {
+		return "", err
+	}
+	defer sorted.Close()
+	if _, err := sorter.WriteTo(sorted); err != nil {
+		os.Remove(sorted.Name())
+		return "", err
+	}
+	return sorted.Name(), nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// Profiles the records in the file: fields and subfields used, indicator
+// values, types of records, encoding levels, record sizes, and parse
+// errors. Use format "stats" for a text report or "stats-json" for a
+// JSON report.
+func toStats(params ProcessFileParams) error {
+	if params.HasFilters() {
+		return errors.New("filters not supported for this format")
+	}
+
+	stats := marc.NewStats()
+
+	format := func(r marc.Record) (interface{}, error) {
+		return nil, nil
+	}
+
+	var out int
+	write := func(res recordResult) error {
+		if res.err != nil {
+			stats.AddError(res.err)
+		} else if res.matched {
+			stats.Add(res.record)
+		} else {
+			return nil
+		}
+		if out++; out == count {
+			return marc.ErrStopPipeline
+		}
+		return nil
+	}
+
+	if err := processRecords(params, format, write); err != nil {
+		return err
+	}
+
+	if params.format == "stats-json" {
+		b, err := json.Marshal(stats)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(params.output, "%s%s", b, params.NewLine())
+		return nil
+	}
+
+	nl := params.NewLine()
+	fmt.Fprintf(params.output, "Records: %d%s", stats.Records, nl)
+	fmt.Fprintf(params.output, "Parse errors: %d%s%s", stats.ParseErrors, nl, nl)
+
+	w := tabwriter.NewWriter(params.output, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(w, "Tag\tRecords\tOccurrences\tSubfields\tIndicator 1\tIndicator 2%s", nl)
+	for _, tag := range stats.SortedTags() {
+		tagStats := stats.Tags[tag]
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s%s", tag, tagStats.Records, tagStats.Occurrences,
+			countsString(tagStats.SubFields), countsString(tagStats.Indicator1), countsString(tagStats.Indicator2), nl)
+	}
+	w.Flush()
+
+	fmt.Fprintf(params.output, "%sType of record and bibliographic level (leader/06-07): %s%s", nl, countsString(stats.RecordTypes), nl)
+	fmt.Fprintf(params.output, "Encoding level (leader/17): %s%s", countsString(stats.EncodingLevels), nl)
+
+	fmt.Fprintf(params.output, "%sRecord size (bytes)%s", nl, nl)
+	w = tabwriter.NewWriter(params.output, 0, 4, 2, ' ', 0)
+	for _, bucket := range stats.RecordSizes {
+		if bucket.Max == 0 {
+			fmt.Fprintf(w, "%d+\t%d%s", bucket.Min, bucket.Records, nl)
+		} else {
+			fmt.Fprintf(w, "%d-%d\t%d%s", bucket.Min, bucket.Max, bucket.Records, nl)
+		}
+	}
+	return w.Flush()
+}
+
+// countsString returns the counts in order of their keys,
+// e.g. "a:10 b:4 c:1".
+func countsString(counts map[string]int) string {
+	values := []string{}
+	for _, key := range marc.SortedKeys(counts) {
+		values = append(values, fmt.Sprintf("%s:%d", key, counts[key]))
+	}
+	return strings.Join(values, " ")
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// violation is a validation error along with the record where it was found.
+type violation struct {
+	Record     int    `json:"record"`
+	ControlNum string `json:"controlNum"`
+	Tag        string `json:"tag"`
+	Message    string `json:"message"`
+}
+
+// Validates the records against the MARC 21 rules and reports every
+// violation found. Records that cannot be parsed are reported as a
+// violation too. Use format "validate" for a text report or
+// "validate-json" for a JSON report.
+func toValidate(params ProcessFileParams) error {
+	if params.HasFilters() {
+		return errors.New("filters not supported for this format")
+	}
+
+	if count == 0 {
+		return nil
+	}
+
+	var out, total int
+	asJson := params.format == "validate-json"
+
+	report := func(v violation) {
+		if asJson {
+			if total > 0 {
+				fmt.Fprintf(params.output, ",")
+			}
+			b, _ := json.Marshal(v)
+			fmt.Fprintf(params.output, "%s%s", params.NewLine(), b)
+		} else {
+			fmt.Fprintf(params.output, "record %d (%s) %s: %s%s", v.Record, v.ControlNum, v.Tag, v.Message, params.NewLine())
+		}
+		total += 1
+	}
+
+	format := func(r marc.Record) (interface{}, error) {
+		return r.Validate(), nil
+	}
+
+	write := func(res recordResult) error {
+		if res.err != nil {
+			report(violation{Record: res.position, ControlNum: res.record.ControlNum(), Message: res.err.Error()})
+		} else if res.matched {
+			for _, e := range res.value.([]marc.ValidationError) {
+				report(violation{Record: res.position, ControlNum: res.record.ControlNum(), Tag: e.Tag, Message: e.Message})
+			}
+		} else {
+			return nil
+		}
+		if out++; out == count {
+			return marc.ErrStopPipeline
+		}
+		return nil
+	}
+
+	if asJson {
+		fmt.Fprintf(params.output, "[")
+	}
+	err := processRecords(params, format, write)
+	if asJson {
+		fmt.Fprintf(params.output, "%s]%s", params.NewLine(), params.NewLine())
+	}
+	return err
+}
@@ -4,7 +4,6 @@ import (
 	"encoding/xml"
 	"fmt"
 	"io"
-	"os"
 
 	"github.com/hectorcorrea/marcli/pkg/marc"
 )
@@ -41,53 +40,39 @@ func toXML(params ProcessFileParams) error {
 		return nil
 	}
 
-	file, err := os.Open(params.filename)
-	if err != nil {
-		return err
+	format := func(r marc.Record) (interface{}, error) {
+		return recordToXML(r, params)
 	}
-	defer file.Close()
 
-	fmt.Printf("%s\n%s\n", xmlProlog, xmlRootBegin)
-
-	var i, out int
-	marc := marc.NewMarcFile(file)
-	for marc.Scan() {
-
-		r, err := marc.Record()
-		if err == io.EOF {
-			break
-		}
-
-		if err != nil {
-			printError(r, "PARSE ERROR", err)
+	var out int
+	write := func(res recordResult) error {
+		if res.err != nil {
+			printError(params.output, res.record, "PARSE ERROR", res.err)
 			if params.debug {
-				continue
+				return nil
 			}
-			return err
+			return res.err
 		}
-
-		if i++; i < start {
-			continue
-		}
-
-		if r.Contains(params.searchValue, params.searchRegEx, params.searchFields) && r.HasFields(params.hasFields) {
-			str, err := recordToXML(r, params)
-			if err != nil {
+		if res.matched {
+			if res.formatErr != nil {
 				if params.debug {
-					printError(r, "XML PARSE ERROR", err)
-					continue
+					printError(params.output, res.record, "XML PARSE ERROR", res.formatErr)
+					return nil
 				}
-				panic(err)
+				panic(res.formatErr)
 			}
-			fmt.Printf("%s%s", str, params.NewLine())
+			fmt.Fprintf(params.output, "%s%s", res.value, params.NewLine())
 			if out++; out == count {
-				break
+				return marc.ErrStopPipeline
 			}
 		}
+		return nil
 	}
-	fmt.Printf("%s\n", xmlRootEnd)
 
-	return marc.Err()
+	fmt.Fprintf(params.output, "%s\n%s\n", xmlProlog, xmlRootBegin)
+	err := processRecords(params, format, write)
+	fmt.Fprintf(params.output, "%s\n", xmlRootEnd)
+	return err
 }
 
 func recordToXML(r marc.Record, params ProcessFileParams) (string, error) {
@@ -115,10 +100,10 @@ func recordToXML(r marc.Record, params ProcessFileParams) (string, error) {
 	return string(b), err
 }
 
-func printError(r marc.Record, errType string, err error) {
+func printError(w io.Writer, r marc.Record, errType string, err error) {
 	str := "== RECORD WITH ERROR STARTS HERE\n"
 	str += fmt.Sprintf("%s:\n%s\n", errType, err.Error())
 	str += r.DebugString() + "\n"
 	str += "== RECORD WITH ERROR ENDS HERE\n\n"
-	fmt.Print(str)
+	fmt.Fprint(w, str)
 }
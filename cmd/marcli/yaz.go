@@ -2,8 +2,6 @@ package main
 
 import (
 	"fmt"
-	"io"
-	"os"
 
 	"github.com/hectorcorrea/marcli/pkg/marc"
 )
@@ -15,61 +13,50 @@ func toYaz(params ProcessFileParams) error {
 		return nil
 	}
 
-	file, err := os.Open(params.filename)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	var i, out, recordCount int
-	marc := marc.NewMarcFile(file)
-	for marc.Scan() {
-
-		r, err := marc.Record()
-		if err == io.EOF {
-			break
+	format := func(r marc.Record) (interface{}, error) {
+		str := ""
+		if params.filters.IncludeLeader() {
+			str += fmt.Sprintf("%s%s", r.Leader.Raw(), params.NewLine())
 		}
+		for _, field := range r.Filter(params.filters, params.exclude) {
+			if field.IsControlField() {
+				str += fmt.Sprintf("%s %s%s", field.Tag, field.Value, params.NewLine())
+			} else {
+				str += fmt.Sprintf("%s %s%s ", field.Tag, field.Indicator1, field.Indicator2)
+				for _, sub := range field.SubFields {
+					str += fmt.Sprintf("$%s %s ", sub.Code, sub.Value)
+				}
+				str += params.NewLine()
+			}
+		}
+		return str, nil
+	}
 
-		if err != nil {
+	var out int
+	write := func(res recordResult) error {
+		if res.err != nil {
 			str := "== RECORD WITH ERROR STARTS HERE\n"
-			str += "ERROR:\n" + err.Error() + "\n"
-			str += r.DebugString() + "\n"
+			str += "ERROR:\n" + res.err.Error() + "\n"
+			str += res.record.DebugString() + "\n"
 			str += "== RECORD WITH ERROR ENDS HERE\n\n"
-			fmt.Print(str)
+			fmt.Fprint(params.output, str)
 			if params.debug {
-				continue
+				return nil
 			}
-			return err
+			return res.err
 		}
 
-		if i++; i < start {
-			continue
-		}
-
-		if r.Contains(params.searchValue, params.searchRegEx, params.searchFields) && r.HasFields(params.hasFields) {
-			recordCount += 1
-			str := ""
-			if params.filters.IncludeLeader() {
-				str += fmt.Sprintf("%s%s", r.Leader.Raw(), params.NewLine())
-			}
-			for _, field := range r.Filter(params.filters, params.exclude) {
-				if field.IsControlField() {
-					str += fmt.Sprintf("%s %s%s", field.Tag, field.Value, params.NewLine())
-				} else {
-					str += fmt.Sprintf("%s %s%s ", field.Tag, field.Indicator1, field.Indicator2)
-					for _, sub := range field.SubFields {
-						str += fmt.Sprintf("$%s %s ", sub.Code, sub.Value)
-					}
-					str += params.NewLine()
-				}
-			}
+		if res.matched {
+			str := res.value.(string)
 			if str != "" {
-				fmt.Printf("%s", str)
+				fmt.Fprintf(params.output, "%s", str)
 				if out++; out == count {
-					break
+					return marc.ErrStopPipeline
 				}
 			}
 		}
+		return nil
 	}
-	return marc.Err()
+
+	return processRecords(params, format, write)
 }
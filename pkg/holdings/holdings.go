@@ -0,0 +1,123 @@
+// Package holdings renders the holdings statements of MARC 21 holdings
+// (MFHD) records from the captions and patterns (853-855) and the
+// enumeration and chronology (863-865) fields, as well as the textual
+// holdings (866-868).
+// See https://www.loc.gov/marc/holdings/
+package holdings
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+var ErrNotHoldings = errors.New("record is not a holdings record")
+
+// Types of holdings statements
+const (
+	Basic      = "Basic bibliographic unit"
+	Supplement = "Supplementary material"
+	Index      = "Indexes"
+)
+
+// Holdings represents the holdings statements of an MFHD record.
+type Holdings struct {
+	ControlNum    string // 001
+	BibControlNum string // 004
+	Statements    []Statement
+}
+
+// Statement is a human readable holdings statement, e.g.
+// "v.1(1990)-v.10(1999)".
+type Statement struct {
+	Type     string
+	Link     int    // link number in $8
+	Sequence int    // sequence number in $8
+	Text     string // e.g. "v.1(1990)-v.10(1999)"
+	Note     string // public note, $z
+}
+
+func (s Statement) String() string {
+	if s.Note == "" {
+		return s.Text
+	}
+	return s.Text + " " + s.Note
+}
+
+// pairs defines the caption tag, enumeration tag, and textual holdings
+// tag for each type of statement.
+var pairs = []struct {
+	statementType string
+	caption       string
+	enumeration   string
+	textual       string
+}{
+	{statementType: Basic, caption: "853", enumeration: "863", textual: "866"},
+	{statementType: Supplement, caption: "854", enumeration: "864", textual: "867"},
+	{statementType: Index, caption: "855", enumeration: "865", textual: "868"},
+}
+
+// NewHoldings creates the holdings statements for an MFHD record.
+// Enumeration fields (863-865) are paired with the caption (853-855) that
+// has the same link number in $8. Statements are sorted by type, link,
+// and sequence number.
+func NewHoldings(r marc.Record) (Holdings, error) {
+	h := Holdings{ControlNum: r.GetValue("001", ""), BibControlNum: r.GetValue("004", "")}
+	if !r.Leader.IsHoldings() {
+		return h, ErrNotHoldings
+	}
+
+	for _, pair := range pairs {
+		captions := map[int]marc.Field{}
+		for _, field := range r.FieldsByTag(pair.caption) {
+			link, _ := linkAndSequence(field)
+			captions[link] = field
+		}
+
+		statements := []Statement{}
+		for _, field := range r.FieldsByTag(pair.enumeration) {
+			link, sequence := linkAndSequence(field)
+			statements = append(statements, Statement{
+				Type:     pair.statementType,
+				Link:     link,
+				Sequence: sequence,
+				Text:     render(captions[link], field),
+				Note:     subfieldValue(field, "z"),
+			})
+		}
+		for _, field := range r.FieldsByTag(pair.textual) {
+			link, sequence := linkAndSequence(field)
+			statements = append(statements, Statement{
+				Type:     pair.statementType,
+				Link:     link,
+				Sequence: sequence,
+				Text:     subfieldValue(field, "a"),
+				Note:     subfieldValue(field, "z"),
+			})
+		}
+
+		sort.SliceStable(statements, func(i, j int) bool {
+			if statements[i].Link != statements[j].Link {
+				return statements[i].Link < statements[j].Link
+			}
+			return statements[i].Sequence < statements[j].Sequence
+		})
+		h.Statements = append(h.Statements, statements...)
+	}
+	return h, nil
+}
+
+// linkAndSequence returns the link and sequence numbers in $8
+// (e.g. "1.2"). Missing or invalid values are returned as zero.
+func linkAndSequence(field marc.Field) (int, int) {
+	values := strings.SplitN(subfieldValue(field, "8"), ".", 2)
+	link, _ := strconv.Atoi(values[0])
+	sequence := 0
+	if len(values) == 2 {
+		sequence, _ = strconv.Atoi(values[1])
+	}
+	return link, sequence
+}
@@ -0,0 +1,142 @@
+package holdings
+
+import (
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+func TestNewHoldings(t *testing.T) {
+	t.Parallel()
+
+	records := setUpTestRecords("testdata/test_mfhd.mrc", t)
+	h, err := NewHoldings(records[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if h.ControlNum != "h0000001" || h.BibControlNum != "ocm57175940" {
+		t.Errorf("unexpected control numbers %q, %q", h.ControlNum, h.BibControlNum)
+	}
+
+	want := []Statement{
+		{Type: Basic, Link: 0, Sequence: 0, Text: "v.1-20"},
+		{Type: Basic, Link: 1, Sequence: 1, Text: "v.1:no.1(1990:Jan.)-v.10:no.12(1999:Dec.)"},
+		{Type: Basic, Link: 2, Sequence: 1, Text: "v.12(2001)-"},
+		{Type: Supplement, Link: 1, Sequence: 1, Text: "suppl.1(1995)", Note: "Lacks pages 1-10."},
+	}
+	if !cmp.Equal(h.Statements, want) {
+		t.Errorf("expected %v, got %v", want, h.Statements)
+	}
+	if h.Statements[3].String() != "suppl.1(1995) Lacks pages 1-10." {
+		t.Errorf("unexpected statement %q", h.Statements[3].String())
+	}
+
+	if _, err := NewHoldings(records[1]); err != ErrNotHoldings {
+		t.Errorf("expected %v, got %v", ErrNotHoldings, err)
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		caption     []marc.SubField
+		enumeration []marc.SubField
+		want        string
+	}{
+		{
+			name:        "single issue",
+			caption:     []marc.SubField{{Code: "a", Value: "v."}, {Code: "i", Value: "(year)"}},
+			enumeration: []marc.SubField{{Code: "a", Value: "3"}, {Code: "i", Value: "1992"}},
+			want:        "v.3(1992)",
+		},
+		{
+			name:        "range",
+			caption:     []marc.SubField{{Code: "a", Value: "v."}, {Code: "i", Value: "(year)"}},
+			enumeration: []marc.SubField{{Code: "a", Value: "1-10"}, {Code: "i", Value: "1990-1999"}},
+			want:        "v.1(1990)-v.10(1999)",
+		},
+		{
+			name:        "seasons",
+			caption:     []marc.SubField{{Code: "i", Value: "(year)"}, {Code: "j", Value: "(season)"}},
+			enumeration: []marc.SubField{{Code: "i", Value: "2001"}, {Code: "j", Value: "21-24"}},
+			want:        "2001:Spring-Winter",
+		},
+		{
+			name:        "range of issues in a volume",
+			caption:     []marc.SubField{{Code: "a", Value: "v."}, {Code: "b", Value: "no."}},
+			enumeration: []marc.SubField{{Code: "a", Value: "1"}, {Code: "b", Value: "1-12"}},
+			want:        "v.1:no.1-no.12",
+		},
+		{
+			name:        "range of years in a volume",
+			caption:     []marc.SubField{{Code: "a", Value: "v."}, {Code: "i", Value: "(year)"}},
+			enumeration: []marc.SubField{{Code: "a", Value: "1"}, {Code: "i", Value: "1990-1991"}},
+			want:        "v.1(1990)-(1991)",
+		},
+		{
+			name:        "range of volumes with the same issue",
+			caption:     []marc.SubField{{Code: "a", Value: "v."}, {Code: "b", Value: "no."}},
+			enumeration: []marc.SubField{{Code: "a", Value: "1-10"}, {Code: "b", Value: "1-1"}},
+			want:        "v.1:no.1-v.10:no.1",
+		},
+		{
+			name:        "range of years with the same month",
+			caption:     []marc.SubField{{Code: "i", Value: "(year)"}, {Code: "j", Value: "(month)"}},
+			enumeration: []marc.SubField{{Code: "i", Value: "1990-1991"}, {Code: "j", Value: "01-01"}},
+			want:        "1990:Jan.-1991:Jan.",
+		},
+		{
+			name:        "range of volumes in the same year",
+			caption:     []marc.SubField{{Code: "a", Value: "v."}, {Code: "i", Value: "(year)"}},
+			enumeration: []marc.SubField{{Code: "a", Value: "1-2"}, {Code: "i", Value: "1990"}},
+			want:        "v.1(1990)-v.2(1990)",
+		},
+		{
+			name:        "range with the same start and end",
+			caption:     []marc.SubField{{Code: "a", Value: "v."}},
+			enumeration: []marc.SubField{{Code: "a", Value: "1-1"}},
+			want:        "v.1",
+		},
+		{
+			name:        "no caption",
+			enumeration: []marc.SubField{{Code: "a", Value: "1-5"}},
+			want:        "1-5",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			caption := marc.Field{Tag: "853", SubFields: tt.caption}
+			enumeration := marc.Field{Tag: "863", SubFields: tt.enumeration}
+			if got := render(caption, enumeration); got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func setUpTestRecords(path string, t *testing.T) []marc.Record {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("problem opening test data file %q: %v", path, err)
+	}
+	defer file.Close()
+
+	records := []marc.Record{}
+	f := marc.NewMarcFile(file)
+	for f.Scan() {
+		record, err := f.Record()
+		if err != nil {
+			t.Fatalf("problem getting record: %v", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
@@ -0,0 +1,142 @@
+package holdings
+
+import (
+	"strings"
+
+	"github.com/hectorcorrea/marcli/pkg/marc"
+)
+
+// Subfields with the levels of enumeration and chronology in the
+// caption (853-855) and enumeration (863-865) fields.
+const (
+	enumerationCodes = "abcdef"
+	chronologyCodes  = "ijkl"
+)
+
+var monthsAndSeasons = map[string]string{
+	"01": "Jan.",
+	"02": "Feb.",
+	"03": "Mar.",
+	"04": "Apr.",
+	"05": "May",
+	"06": "June",
+	"07": "July",
+	"08": "Aug.",
+	"09": "Sept.",
+	"10": "Oct.",
+	"11": "Nov.",
+	"12": "Dec.",
+	"21": "Spring",
+	"22": "Summer",
+	"23": "Autumn",
+	"24": "Winter",
+}
+
+// level is one level of enumeration or chronology along with its caption.
+type level struct {
+	caption string
+	start   string
+	end     string
+}
+
+// render returns the holdings statement for an enumeration field using
+// the captions in the caption field, e.g. "v.1(1990)-v.10(1999)".
+// Values with a hyphen (e.g. "1-10") indicate a range, an empty value
+// after the hyphen indicates an open range (e.g. "v.1-"). The end of the
+// range starts at the first level that changes, e.g. "v.1:no.1-no.12"
+// but "v.1:no.1-v.10:no.1".
+func render(caption, enumeration marc.Field) string {
+	var enums, chrons []level
+	isRange := false
+	for _, sub := range enumeration.SubFields {
+		isEnum := strings.Contains(enumerationCodes, sub.Code)
+		isChron := strings.Contains(chronologyCodes, sub.Code)
+		if !isEnum && !isChron {
+			continue
+		}
+
+		l := level{caption: subfieldValue(caption, sub.Code), start: sub.Value, end: sub.Value}
+		if i := strings.Index(sub.Value, "-"); i >= 0 {
+			l.start, l.end = sub.Value[:i], sub.Value[i+1:]
+			isRange = isRange || l.start != l.end
+		}
+		if isEnum {
+			enums = append(enums, l)
+		} else {
+			chrons = append(chrons, l)
+		}
+	}
+
+	startEnum := join(enums, false)
+	text := format(startEnum, join(chrons, false), false)
+	if isRange {
+		// The chronology stays in parenthesis when the enumeration does
+		// not change, e.g. "v.1(1990)-(1991)".
+		endEnums, endChrons := endLevels(enums, chrons)
+		text += "-" + format(join(endEnums, true), join(endChrons, true), startEnum != "")
+	}
+	return text
+}
+
+// endLevels returns the levels of enumeration and chronology to display
+// at the end of a range: the levels from the first one that changes, or
+// none for an open range.
+func endLevels(enums, chrons []level) ([]level, []level) {
+	all := append(append([]level{}, enums...), chrons...)
+	first := 0
+	for first < len(all) && all[first].start == all[first].end {
+		first++
+	}
+	if first == len(all) || all[first].end == "" {
+		return nil, nil
+	}
+	if first < len(enums) {
+		return enums[first:], chrons
+	}
+	return nil, chrons[first-len(enums):]
+}
+
+// format returns the start (or end) of a statement, the chronology is
+// displayed in parenthesis after the enumeration.
+func format(enumText, chronText string, hasEnum bool) string {
+	if chronText != "" && (enumText != "" || hasEnum) {
+		return enumText + "(" + chronText + ")"
+	}
+	return enumText + chronText
+}
+
+// join joins the values of the levels with their captions. Captions in
+// parenthesis, e.g. "(year)", are not displayed.
+func join(levels []level, end bool) string {
+	values := []string{}
+	for _, l := range levels {
+		value := l.start
+		if end {
+			value = l.end
+		}
+		if value == "" {
+			continue
+		}
+
+		caption := strings.ToLower(l.caption)
+		if strings.Contains(caption, "month") || strings.Contains(caption, "season") {
+			if name, ok := monthsAndSeasons[value]; ok {
+				value = name
+			}
+		}
+		if strings.HasPrefix(l.caption, "(") {
+			values = append(values, value)
+		} else {
+			values = append(values, l.caption+value)
+		}
+	}
+	return strings.Join(values, ":")
+}
+
+// subfieldValue returns the value of the first subfield with the code.
+func subfieldValue(field marc.Field, code string) string {
+	for _, sub := range field.GetSubFields(code) {
+		return sub.Value
+	}
+	return ""
+}
@@ -0,0 +1,114 @@
+package marc
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrNoHeading = errors.New("record has no heading (1XX) field")
+
+// Heading represents the heading (1XX) or a tracing (4XX and 5XX) of an
+// authority record.
+// See https://www.loc.gov/marc/authority/
+type Heading struct {
+	Tag          string // e.g. "100"
+	Type         string // e.g. "Personal name"
+	Value        string // e.g. "Twain, Mark, 1835-1910"
+	Relationship string // $i, for tracings only
+	Control      string // $w, for tracings only
+}
+
+var headingTypes = map[string]string{
+	"00": "Personal name",
+	"10": "Corporate name",
+	"11": "Meeting name",
+	"30": "Uniform title",
+	"47": "Named event",
+	"48": "Chronological term",
+	"50": "Topical term",
+	"51": "Geographic name",
+	"55": "Genre/form term",
+	"62": "Medium of performance term",
+	"80": "General subdivision",
+	"81": "Geographic subdivision",
+	"82": "Chronological subdivision",
+	"85": "Form subdivision",
+}
+
+// Subfields that are not part of the text of a heading
+const headingControlSubfields = "0124568iw"
+
+// Subfields that are subject subdivisions
+const headingSubdivisions = "vxyz"
+
+// NewHeading creates a heading from an authority 1XX, 4XX, or 5XX field.
+// Subject subdivisions ($v, $x, $y, and $z) are separated by "--" in the
+// value.
+func NewHeading(field Field) Heading {
+	h := Heading{Tag: field.Tag}
+	if len(field.Tag) == 3 {
+		h.Type = headingTypes[field.Tag[1:]]
+	}
+	for _, sub := range field.SubFields {
+		value := strings.TrimSpace(sub.Value)
+		switch {
+		case sub.Code == "i":
+			h.Relationship = value
+		case sub.Code == "w":
+			h.Control = value
+		case value == "" || strings.Contains(headingControlSubfields, sub.Code):
+			continue
+		case h.Value == "":
+			h.Value = value
+		case strings.Contains(headingSubdivisions, sub.Code):
+			h.Value += "--" + value
+		default:
+			h.Value += " " + value
+		}
+	}
+	return h
+}
+
+func (h Heading) String() string {
+	return h.Value
+}
+
+// Heading returns the heading (1XX) of an authority record.
+func (r Record) Heading() (Heading, error) {
+	for _, field := range r.Fields {
+		if isHeadingTag(field.Tag, '1') {
+			return NewHeading(field), nil
+		}
+	}
+	return Heading{}, ErrNoHeading
+}
+
+// SeeFrom returns the see from tracings (4XX) of an authority record.
+func (r Record) SeeFrom() []Heading {
+	return r.tracings('4')
+}
+
+// SeeAlso returns the see also from tracings (5XX) of an authority record.
+func (r Record) SeeAlso() []Heading {
+	return r.tracings('5')
+}
+
+func (r Record) tracings(block byte) []Heading {
+	headings := []Heading{}
+	for _, field := range r.Fields {
+		if isHeadingTag(field.Tag, block) {
+			headings = append(headings, NewHeading(field))
+		}
+	}
+	return headings
+}
+
+// isHeadingTag returns true if the tag is a heading (or tracing)
+// tag in the indicated block, e.g. 100, 151, or 450 but not 190.
+func isHeadingTag(tag string, block byte) bool {
+	if len(tag) != 3 || tag[0] != block {
+		return false
+	}
+	_, ok := headingTypes[tag[1:]]
+	return ok
+}
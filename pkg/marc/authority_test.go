@@ -0,0 +1,107 @@
+package marc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAuthorityHeadings(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_auth.mrc", t)
+	if !record.Leader.IsAuthority() {
+		t.Fatalf("expected an authority record")
+	}
+
+	heading, err := record.Heading()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := Heading{Tag: "100", Type: "Personal name", Value: "Twain, Mark, 1835-1910"}
+	if !cmp.Equal(heading, want) {
+		t.Errorf("expected %v, got %v", want, heading)
+	}
+
+	seeFrom := record.SeeFrom()
+	if len(seeFrom) != 2 || seeFrom[0].Value != "Clemens, Samuel Langhorne, 1835-1910" {
+		t.Errorf("unexpected see from tracings %v", seeFrom)
+	}
+
+	seeAlso := record.SeeAlso()
+	want = Heading{Tag: "500", Type: "Personal name", Value: "Conte, Louis de, 1835-1910", Relationship: "Alternate identity:", Control: "r"}
+	if len(seeAlso) != 1 || !cmp.Equal(seeAlso[0], want) {
+		t.Errorf("expected %v, got %v", want, seeAlso)
+	}
+
+	if _, err := (Record{}).Heading(); err != ErrNoHeading {
+		t.Errorf("expected %v, got %v", ErrNoHeading, err)
+	}
+}
+
+func TestNewHeading(t *testing.T) {
+	t.Parallel()
+
+	field := Field{Tag: "150", Indicator1: " ", Indicator2: " ", SubFields: []SubField{
+		{Code: "a", Value: "Diabetes"},
+		{Code: "x", Value: "Complications"},
+		{Code: "z", Value: "United States"},
+		{Code: "0", Value: "http://id.loc.gov/authorities/subjects/sh85037655"},
+	}}
+	want := Heading{Tag: "150", Type: "Topical term", Value: "Diabetes--Complications--United States"}
+	if got := NewHeading(field); !cmp.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestAuthorityField008(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_auth.mrc", t)
+	f, err := record.AuthorityField008()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  FixedValue
+		want FixedValue
+	}{
+		{name: "date entered", got: f.DateEntered, want: FixedValue{Code: "790409"}},
+		{name: "geographic subdivision", got: f.GeographicSubdivision, want: FixedValue{Code: "n", Label: "Not applicable"}},
+		{name: "kind of record", got: f.KindOfRecord, want: FixedValue{Code: "a", Label: "Established heading"}},
+		{name: "descriptive rules", got: f.DescriptiveRules, want: FixedValue{Code: "z", Label: "Other"}},
+		{name: "thesaurus", got: f.SubjectHeadingSystem, want: FixedValue{Code: "a", Label: "Library of Congress Subject Headings"}},
+		{name: "subject use", got: f.SubjectAddedEntryUse, want: FixedValue{Code: "a", Label: "Appropriate"}},
+		{name: "series use", got: f.SeriesAddedEntryUse, want: FixedValue{Code: "b", Label: "Not appropriate"}},
+		{name: "level of establishment", got: f.LevelOfEstablishment, want: FixedValue{Code: "a", Label: "Fully established"}},
+	}
+	for _, tt := range tests {
+		if !cmp.Equal(tt.got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, tt.got)
+		}
+	}
+	if len(f.Elements) != 20 {
+		t.Errorf("expected 20 elements, got %d", len(f.Elements))
+	}
+}
+
+func TestValidateAuthority(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_auth.mrc", t)
+	if errs := record.Validate(); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	record.AddField(Field{Tag: "150", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a", Value: "Humorists"}}})
+	record.AddField(Field{Tag: "245", Indicator1: "1", Indicator2: "0", SubFields: []SubField{{Code: "a", Value: "Title"}}})
+	want := []ValidationError{
+		{Tag: "245", Message: "undefined tag"},
+		{Tag: "1XX", Message: "record has more than one heading"},
+	}
+	if got := record.Validate(); !cmp.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
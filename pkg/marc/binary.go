@@ -0,0 +1,86 @@
+package marc
+
+import (
+	"bytes"
+	"fmt"
+)
+
+const (
+	directoryEntryLength = 12
+	maxRecordLength      = 99999
+	defaultLeader        = "00000nam a2200000   4500"
+)
+
+// MarshalBinary encodes the record in MARC binary (ISO 2709) from the
+// values in Fields, including the record terminator. Unlike Raw(), which
+// returns the bytes as they were read, the result reflects any changes
+// made to the record (e.g. filtered fields) and it is also available
+// for records read from MARC XML.
+func (r Record) MarshalBinary() ([]byte, error) {
+	data, err := r.binary()
+	if err != nil {
+		return nil, err
+	}
+	return append(data, rt), nil
+}
+
+// binary serializes the record to MARC binary (ISO 2709) from the
+// values in Fields. The record length and base address of data in the
+// leader are recalculated. The record terminator is not included.
+func (r Record) binary() ([]byte, error) {
+	var dirs, data bytes.Buffer
+	for _, field := range r.Fields {
+		start := data.Len()
+		if field.IsControlField() {
+			data.WriteString(field.Value)
+		} else {
+			data.WriteString(indicatorOrBlank(field.Indicator1))
+			data.WriteString(indicatorOrBlank(field.Indicator2))
+			for _, sub := range field.SubFields {
+				data.WriteByte(st)
+				data.WriteString(sub.Code)
+				data.WriteString(sub.Value)
+			}
+		}
+		// Delimiters in the values would break the structure of the record
+		if bytes.ContainsAny(data.Bytes()[start:], string([]byte{rt, ft})) ||
+			bytes.Count(data.Bytes()[start:], []byte{st}) != len(field.SubFields) {
+			return nil, fmt.Errorf("field %s: %w", field.Tag, ErrInvalidFieldValue)
+		}
+		data.WriteByte(ft)
+		length := data.Len() - start
+		if len(field.Tag) != 3 || length > 9999 || start > 99999 {
+			return nil, fmt.Errorf("field %s cannot be encoded in MARC binary", field.Tag)
+		}
+		fmt.Fprintf(&dirs, "%s%04d%05d", field.Tag, length, start)
+	}
+	dirs.WriteByte(ft)
+
+	baseAddress := leaderLength + dirs.Len()
+	recordLength := baseAddress + data.Len() + 1 // include record terminator
+	if recordLength > maxRecordLength {
+		return nil, ErrBadRecordLength
+	}
+
+	leader := []byte(defaultLeader)
+	if len(r.Leader.raw) == leaderLength {
+		copy(leader, r.Leader.raw)
+	}
+	copy(leader[0:5], fmt.Sprintf("%05d", recordLength))
+	copy(leader[10:12], "22")
+	copy(leader[offsetStart:offsetEnd], fmt.Sprintf("%05d", baseAddress))
+	copy(leader[20:24], "4500")
+
+	var buf bytes.Buffer
+	buf.Write(leader)
+	buf.Write(dirs.Bytes())
+	buf.Write(data.Bytes())
+	return buf.Bytes(), nil
+}
+
+func indicatorOrBlank(value string) string {
+	if len(value) != 1 {
+		return " "
+	}
+	return value
+}
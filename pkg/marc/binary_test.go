@@ -0,0 +1,90 @@
+package marc
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		path string
+	}{
+		{name: "binary", path: "testdata/test_1a.mrc"},
+		{name: "XML", path: "testdata/test_10.xml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := setUpTestRecord(tt.path, t)
+			data, err := want.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			f := NewMarcFile(bytes.NewBuffer(data))
+			f.Scan()
+			got, err := f.Record()
+			if err != nil {
+				t.Fatalf("problem calling Record on MarcFile: %s", err)
+			}
+
+			if !cmp.Equal(want.Fields, got.Fields) {
+				t.Error(cmp.Diff(want.Fields, got.Fields))
+			}
+			if want.Leader.Raw() != got.Leader.Raw() {
+				t.Errorf("expected %q, got %q", want.Leader.Raw(), got.Leader.Raw())
+			}
+		})
+	}
+}
+
+func TestMarshalBinary_SameAsRaw(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	got, err := record.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, record.Raw()) {
+		t.Errorf("expected %q, got %q", record.Raw(), got)
+	}
+}
+
+func TestMarshalBinary_Filtered(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	record.Fields = record.Filter(NewFieldFilters("001,245a"), FieldFilters{})
+	data, err := record.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "00202nam a2200049 i 4500001001200000245014000012\x1eocm57175940\x1e10\x1faGuidelines for sample collecting and analytical methods used in the U.S. Geological Survey for determining chemical composition of coal\x1e\x1d"
+	if string(data) != want {
+		t.Errorf("expected %q, got %q", want, data)
+	}
+}
+
+func TestMarshalBinary_Delimiters(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	record.Fields[0].Value = "ocm1\x1eocm2"
+	if _, err := record.MarshalBinary(); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("expected %q, got %q", ErrInvalidFieldValue, err)
+	}
+
+	record = setUpTestRecord("testdata/test_1a.mrc", t)
+	record.FieldsByTag("245")[0].SubFields[0].Value = "Guidelines\x1fhnot a subfield"
+	if _, err := record.MarshalBinary(); !errors.Is(err, ErrInvalidFieldValue) {
+		t.Errorf("expected %q, got %q", ErrInvalidFieldValue, err)
+	}
+}
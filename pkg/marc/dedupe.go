@@ -0,0 +1,457 @@
+package marc
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidMatchKey = errors.New("invalid match key (valid values are isbn, issn, oclc, lccn, and title)")
+var ErrInvalidSurvivorRule = errors.New("invalid survivor rule (valid values are encoding, fields, and latest)")
+
+// Match keys used to find duplicate records.
+const (
+	MatchISBN  = "isbn"  // 020a normalized to ISBN-13
+	MatchISSN  = "issn"  // 022a
+	MatchOCLC  = "oclc"  // 035a with the (OCoLC) prefix, or the 001 of OCLC records
+	MatchLCCN  = "lccn"  // 010a normalized as described by the Library of Congress
+	MatchTitle = "title" // 245abnp, Date1 (008/07-10), and the first word of the main entry
+)
+
+// Criteria used to decide which record in a cluster of duplicates survives.
+const (
+	SurvivorEncoding = "encoding" // highest encoding level (leader/17)
+	SurvivorFields   = "fields"   // most fields
+	SurvivorLatest   = "latest"   // newest date and time of latest transaction (005)
+)
+
+// DefaultMatchKeys and DefaultSurvivorRule are the values used when
+// none are indicated.
+var DefaultMatchKeys = []string{MatchISBN, MatchISSN, MatchOCLC, MatchLCCN, MatchTitle}
+var DefaultSurvivorRule = SurvivorRule{SurvivorEncoding, SurvivorFields, SurvivorLatest}
+
+// Encoding levels (leader/17) from the most to the least complete,
+// including the OCLC specific values (I, K, L, and M).
+const encodingLevelRank = " 1IL42KM7358uz"
+
+// ParseMatchKeys parses a comma delimited list of match keys
+// (e.g. "isbn,oclc,title").
+func ParseMatchKeys(str string) ([]string, error) {
+	keys := []string{}
+	for _, value := range strings.Split(str, ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+		switch value {
+		case "":
+			continue
+		case MatchISBN, MatchISSN, MatchOCLC, MatchLCCN, MatchTitle:
+			keys = append(keys, value)
+		default:
+			return nil, ErrInvalidMatchKey
+		}
+	}
+	if len(keys) == 0 {
+		return nil, ErrInvalidMatchKey
+	}
+	return keys, nil
+}
+
+// SurvivorRule is the list of criteria used, in order, to decide which
+// record in a cluster of duplicates survives. When the records are tied
+// on all the criteria the first one in the file survives.
+type SurvivorRule []string
+
+// ParseSurvivorRule parses a comma delimited list of criteria
+// (e.g. "encoding,fields,latest").
+func ParseSurvivorRule(str string) (SurvivorRule, error) {
+	rule := SurvivorRule{}
+	for _, value := range strings.Split(str, ",") {
+		value = strings.ToLower(strings.TrimSpace(value))
+		switch value {
+		case "":
+			continue
+		case SurvivorEncoding, SurvivorFields, SurvivorLatest:
+			rule = append(rule, value)
+		default:
+			return nil, ErrInvalidSurvivorRule
+		}
+	}
+	if len(rule) == 0 {
+		return nil, ErrInvalidSurvivorRule
+	}
+	return rule, nil
+}
+
+// better returns true if record a should survive over record b.
+func (rule SurvivorRule) better(a DedupeRecord, b DedupeRecord) bool {
+	for _, criteria := range rule {
+		switch criteria {
+		case SurvivorEncoding:
+			rankA, rankB := encodingRank(a.EncodingLevel), encodingRank(b.EncodingLevel)
+			if rankA != rankB {
+				return rankA < rankB
+			}
+		case SurvivorFields:
+			if a.Fields != b.Fields {
+				return a.Fields > b.Fields
+			}
+		case SurvivorLatest:
+			if a.Latest != b.Latest {
+				return a.Latest > b.Latest
+			}
+		}
+	}
+	return a.Position < b.Position
+}
+
+func encodingRank(level string) int {
+	if len(level) != 1 {
+		return len(encodingLevelRank)
+	}
+	rank := strings.Index(encodingLevelRank, level)
+	if rank == -1 {
+		return len(encodingLevelRank)
+	}
+	return rank
+}
+
+// DedupeRecord has the information of a record needed to find its
+// duplicates and to decide which one survives.
+type DedupeRecord struct {
+	Position      int      `json:"position"` // position in the file (one based)
+	ControlNum    string   `json:"controlNum"`
+	Keys          []string `json:"keys"`
+	EncodingLevel string   `json:"encodingLevel"`
+	Fields        int      `json:"fields"`
+	Latest        string   `json:"latest"`
+}
+
+// NewDedupeRecord returns the match keys (see MatchKeys) and the survivor
+// criteria of a record. The Position is left for the caller to set.
+func NewDedupeRecord(r Record, keys []string) DedupeRecord {
+	rec := DedupeRecord{
+		ControlNum: r.ControlNum(),
+		Keys:       MatchKeys(r, keys),
+		Fields:     len(r.Fields),
+		Latest:     r.GetValue("005", ""),
+	}
+	if raw := r.Leader.Raw(); len(raw) == leaderLength {
+		rec.EncodingLevel = raw[17:18]
+	}
+	return rec
+}
+
+// MatchKeys returns the normalized match keys of a record prefixed with
+// their type, e.g. "isbn:9780306406157" or "oclc:57175940". Two records
+// that have a key in common are considered duplicates.
+func MatchKeys(r Record, keys []string) []string {
+	values := []string{}
+	add := func(key string, value string) {
+		if value == "" {
+			return
+		}
+		value = key + ":" + value
+		for _, v := range values {
+			if v == value {
+				return
+			}
+		}
+		values = append(values, value)
+	}
+
+	for _, key := range keys {
+		switch key {
+		case MatchISBN:
+			for _, value := range r.GetValues("020", "a") {
+				add(key, normalizeISBN(value))
+			}
+		case MatchISSN:
+			for _, value := range r.GetValues("022", "a") {
+				add(key, normalizeISSN(value))
+			}
+		case MatchOCLC:
+			for _, value := range r.GetValues("035", "a") {
+				if strings.HasPrefix(value, "(OCoLC)") {
+					add(key, normalizeOCLC(value[len("(OCoLC)"):]))
+				}
+			}
+			id := r.ControlNum()
+			if r.GetValue("003", "") == "OCoLC" || strings.HasPrefix(id, "oc") || strings.HasPrefix(id, "on") {
+				add(key, normalizeOCLC(id))
+			}
+		case MatchLCCN:
+			for _, value := range r.GetValues("010", "a") {
+				add(key, normalizeLCCN(value))
+			}
+		case MatchTitle:
+			add(key, titleKey(r))
+		}
+	}
+	return values
+}
+
+// normalizeISBN returns the ISBN-13 for an ISBN-10 or ISBN-13 with or
+// without hyphens and qualifiers (e.g. "0-306-40615-2 (pbk.)"). ISBNs
+// with a wrong check digit return an empty string so that a mistyped
+// ISBN does not match an unrelated record.
+func normalizeISBN(value string) string {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return ""
+	}
+	isbn := strings.ToUpper(strings.ReplaceAll(fields[0], "-", ""))
+	if len(isbn) == 13 && isDigits(isbn) {
+		if isbn13CheckDigit(isbn[:12]) != isbn[12] {
+			return ""
+		}
+		return isbn
+	}
+	if len(isbn) != 10 || !isDigits(isbn[:9]) || !(isDigits(isbn[9:]) || isbn[9] == 'X') {
+		return ""
+	}
+
+	// The check digit of an ISBN-10 makes the weighted sum of its
+	// digits (10 to 1, X is 10) a multiple of 11.
+	sum := 0
+	for i, c := range isbn {
+		digit := int(c - '0')
+		if c == 'X' {
+			digit = 10
+		}
+		sum += digit * (10 - i)
+	}
+	if sum%11 != 0 {
+		return ""
+	}
+
+	isbn = "978" + isbn[:9]
+	return isbn + string(isbn13CheckDigit(isbn))
+}
+
+// isbn13CheckDigit returns the check digit for the first 12 digits of an
+// ISBN-13, the digits are weighted 1 and 3 alternately.
+func isbn13CheckDigit(isbn string) byte {
+	sum := 0
+	for i, c := range isbn {
+		digit := int(c - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+// normalizeISSN returns the ISSN without the hyphen (e.g. "2434561X").
+func normalizeISSN(value string) string {
+	fields := strings.Fields(value)
+	if len(fields) == 0 {
+		return ""
+	}
+	issn := strings.ToUpper(strings.ReplaceAll(fields[0], "-", ""))
+	if len(issn) != 8 || !isDigits(issn[:7]) || !(isDigits(issn[7:]) || issn[7] == 'X') {
+		return ""
+	}
+
+	// The check digit of an ISSN is computed modulus 11 with the first
+	// seven digits weighted 8 to 2, X stands for 10.
+	sum := 0
+	for i, c := range issn[:7] {
+		sum += int(c-'0') * (8 - i)
+	}
+	check := "0123456789X"[(11-sum%11)%11]
+	if issn[7] != check {
+		return ""
+	}
+	return issn
+}
+
+// normalizeOCLC returns the OCLC number without prefixes (ocm, ocn, on)
+// and leading zeros.
+func normalizeOCLC(value string) string {
+	value = strings.TrimLeft(strings.TrimSpace(value), "ocmn")
+	value = strings.TrimLeft(value, "0")
+	if !isDigits(value) {
+		return ""
+	}
+	return value
+}
+
+// normalizeLCCN normalizes an LCCN as described in
+// https://www.loc.gov/marc/lccn-namespace.html#normalization
+// (e.g. "n78-890351" becomes "n78890351").
+func normalizeLCCN(value string) string {
+	value = strings.Join(strings.Fields(value), "")
+	if i := strings.Index(value, "/"); i != -1 {
+		value = value[:i]
+	}
+	if i := strings.Index(value, "-"); i != -1 {
+		serial := value[i+1:]
+		if !isDigits(serial) || len(serial) > 6 {
+			return ""
+		}
+		value = value[:i] + strings.Repeat("0", 6-len(serial)) + serial
+	}
+	return value
+}
+
+// titleKey returns a key with the title (245abnp without the nonfiling
+// characters), Date1 (008/07-10), and the first word of the main entry
+// (100, 110, or 111), e.g. "guidelines for sample collecting/1976/swanson".
+func titleKey(r Record) string {
+	fields := r.FieldsByTag("245")
+	if len(fields) == 0 {
+		return ""
+	}
+	values := []string{}
+	for _, sub := range fields[0].GetSubFields("abnp") {
+		values = append(values, sub.Value)
+	}
+	title := strings.Join(values, " ")
+	if skip := fields[0].nonfilingCharacters(); skip < len(title) {
+		title = title[skip:]
+	}
+	title = normalizeWords(title)
+	if title == "" {
+		return ""
+	}
+
+	date := ""
+	if value := r.GetValue("008", ""); len(value) >= 11 {
+		date = strings.TrimSpace(value[7:11])
+	}
+
+	author := ""
+	for _, tag := range []string{"100", "110", "111"} {
+		if words := strings.Fields(normalizeWords(r.GetValue(tag, "a"))); len(words) > 0 {
+			author = words[0]
+			break
+		}
+	}
+	return title + "/" + date + "/" + author
+}
+
+// normalizeWords lowercases the value and removes the punctuation.
+func normalizeWords(value string) string {
+	value = strings.Map(func(c rune) rune {
+		if unicode.IsLetter(c) || unicode.IsDigit(c) {
+			return unicode.ToLower(c)
+		}
+		if c == '\'' {
+			return -1
+		}
+		return ' '
+	}, value)
+	return strings.Join(strings.Fields(value), " ")
+}
+
+func isDigits(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return value != ""
+}
+
+// Cluster is a group of records that share match keys. The survivor is
+// the record to keep and the duplicates are the ones to discard.
+type Cluster struct {
+	Keys       []string       `json:"keys"`
+	Survivor   DedupeRecord   `json:"survivor"`
+	Duplicates []DedupeRecord `json:"duplicates"`
+}
+
+// Deduper clusters records that share match keys. Records are added with
+// Add and the clusters are calculated with Clusters. Only the match keys
+// and the survivor criteria of each record are kept in memory.
+type Deduper struct {
+	rule    SurvivorRule
+	records []DedupeRecord
+	parent  []int          // parent of each record in the cluster tree
+	byKey   map[string]int // first record with each key
+}
+
+// NewDeduper creates a Deduper that uses the given rule to decide which
+// record in a cluster survives.
+func NewDeduper(rule SurvivorRule) *Deduper {
+	return &Deduper{rule: rule, byKey: map[string]int{}}
+}
+
+// Add adds a record, the records must be added in the order of the file.
+func (d *Deduper) Add(rec DedupeRecord) {
+	i := len(d.records)
+	d.records = append(d.records, rec)
+	d.parent = append(d.parent, i)
+	for _, key := range rec.Keys {
+		if j, ok := d.byKey[key]; ok {
+			d.union(i, j)
+		} else {
+			d.byKey[key] = i
+		}
+	}
+}
+
+func (d *Deduper) root(i int) int {
+	for d.parent[i] != i {
+		d.parent[i] = d.parent[d.parent[i]]
+		i = d.parent[i]
+	}
+	return i
+}
+
+func (d *Deduper) union(i int, j int) {
+	rootI, rootJ := d.root(i), d.root(j)
+	if rootI < rootJ {
+		d.parent[rootJ] = rootI
+	} else {
+		d.parent[rootI] = rootJ
+	}
+}
+
+// Clusters returns the clusters of records that have at least one
+// duplicate, in the order of the first record of each cluster in the file.
+// The duplicates in each cluster are in the order of the file too.
+func (d *Deduper) Clusters() []Cluster {
+	members := map[int][]int{}
+	roots := []int{}
+	for i := range d.records {
+		root := d.root(i)
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], i)
+	}
+
+	clusters := []Cluster{}
+	for _, root := range roots {
+		if len(members[root]) < 2 {
+			continue
+		}
+		survivor := root
+		keyCount := map[string]int{}
+		for _, i := range members[root] {
+			if d.rule.better(d.records[i], d.records[survivor]) {
+				survivor = i
+			}
+			for _, key := range d.records[i].Keys {
+				keyCount[key]++
+			}
+		}
+
+		cluster := Cluster{Keys: []string{}, Survivor: d.records[survivor]}
+		for key, count := range keyCount {
+			if count > 1 {
+				cluster.Keys = append(cluster.Keys, key)
+			}
+		}
+		sort.Strings(cluster.Keys)
+		for _, i := range members[root] {
+			if i != survivor {
+				cluster.Duplicates = append(cluster.Duplicates, d.records[i])
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+	return clusters
+}
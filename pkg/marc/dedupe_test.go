@@ -0,0 +1,113 @@
+package marc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNormalizeMatchKeys(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		normalize func(string) string
+		value     string
+		want      string
+	}{
+		{name: "isbn-10", normalize: normalizeISBN, value: "0-306-40615-2 (pbk.)", want: "9780306406157"},
+		{name: "isbn-10 with X", normalize: normalizeISBN, value: "080442957X", want: "9780804429573"},
+		{name: "isbn-13", normalize: normalizeISBN, value: "978-0-306-40615-7", want: "9780306406157"},
+		{name: "invalid isbn", normalize: normalizeISBN, value: "03064061", want: ""},
+		{name: "isbn-10 with bad check digit", normalize: normalizeISBN, value: "0-306-40615-3", want: ""},
+		{name: "isbn-13 with bad check digit", normalize: normalizeISBN, value: "978-0-306-40615-2", want: ""},
+		{name: "issn", normalize: normalizeISSN, value: "0317-8471", want: "03178471"},
+		{name: "issn with X", normalize: normalizeISSN, value: "2434-561x", want: "2434561X"},
+		{name: "issn with bad check digit", normalize: normalizeISSN, value: "0317-847x", want: ""},
+		{name: "invalid issn", normalize: normalizeISSN, value: "0317-84", want: ""},
+		{name: "oclc prefix", normalize: normalizeOCLC, value: "ocm00057175", want: "57175"},
+		{name: "oclc number", normalize: normalizeOCLC, value: "1234567890", want: "1234567890"},
+		{name: "invalid oclc", normalize: normalizeOCLC, value: "DLC123", want: ""},
+		{name: "lccn with hyphen", normalize: normalizeLCCN, value: "n78-890351", want: "n78890351"},
+		{name: "lccn short serial", normalize: normalizeLCCN, value: "85-2 ", want: "85000002"},
+		{name: "lccn with suffix", normalize: normalizeLCCN, value: "   75425165 //r75", want: "75425165"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.normalize(tt.value); got != tt.want {
+			t.Errorf("%s: expected %q for %q, got %q", tt.name, tt.want, tt.value, got)
+		}
+	}
+}
+
+func TestMatchKeys(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	record.InsertField(Field{Tag: "010", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a", Value: "  76000123 "}}})
+	record.InsertField(Field{Tag: "020", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a", Value: "0306406152"}}})
+	record.InsertField(Field{Tag: "035", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a", Value: "(OCoLC)57175940"}}})
+
+	want := []string{
+		"isbn:9780306406157",
+		"oclc:57175940",
+		"lccn:76000123",
+		"title:guidelines for sample collecting and analytical methods used in the u s geological survey for determining chemical composition of coal/1976/swanson",
+	}
+	got := MatchKeys(record, DefaultMatchKeys)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+
+	if _, err := ParseMatchKeys("isbn,author"); err != ErrInvalidMatchKey {
+		t.Errorf("expected error %v, got %v", ErrInvalidMatchKey, err)
+	}
+}
+
+func TestDeduperClusters(t *testing.T) {
+	t.Parallel()
+
+	records := []DedupeRecord{
+		{Position: 1, ControlNum: "a", Keys: []string{"isbn:1", "title:x"}, EncodingLevel: "7", Fields: 10, Latest: "2001"},
+		{Position: 2, ControlNum: "b", Keys: []string{"isbn:2"}, EncodingLevel: " ", Fields: 5, Latest: "2000"},
+		{Position: 3, ControlNum: "c", Keys: []string{"oclc:3", "title:x"}, EncodingLevel: " ", Fields: 8, Latest: "1999"},
+		{Position: 4, ControlNum: "d", Keys: []string{"oclc:3"}, EncodingLevel: "I", Fields: 20, Latest: "2010"},
+		{Position: 5, ControlNum: "e", Keys: []string{"oclc:5"}, EncodingLevel: "3", Fields: 5, Latest: "2000"},
+		{Position: 6, ControlNum: "f", Keys: []string{"oclc:5"}, EncodingLevel: "3", Fields: 5, Latest: "2000"},
+	}
+
+	tests := []struct {
+		rule SurvivorRule
+		want []string // survivor and duplicates of each cluster
+	}{
+		{rule: DefaultSurvivorRule, want: []string{"c a d", "e f"}},
+		{rule: SurvivorRule{SurvivorFields}, want: []string{"d a c", "e f"}},
+		{rule: SurvivorRule{SurvivorLatest, SurvivorEncoding}, want: []string{"d a c", "e f"}},
+	}
+
+	for _, tt := range tests {
+		deduper := NewDeduper(tt.rule)
+		for _, rec := range records {
+			deduper.Add(rec)
+		}
+		got := []string{}
+		for _, cluster := range deduper.Clusters() {
+			str := cluster.Survivor.ControlNum
+			for _, dup := range cluster.Duplicates {
+				str += " " + dup.ControlNum
+			}
+			got = append(got, str)
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%v: mismatch (-want +got):\n%s", tt.rule, diff)
+		}
+	}
+
+	deduper := NewDeduper(DefaultSurvivorRule)
+	for _, rec := range records {
+		deduper.Add(rec)
+	}
+	if keys := deduper.Clusters()[0].Keys; !cmp.Equal(keys, []string{"oclc:3", "title:x"}) {
+		t.Errorf("unexpected cluster keys %v", keys)
+	}
+}
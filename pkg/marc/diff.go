@@ -0,0 +1,301 @@
+package marc
+
+import (
+	"io"
+)
+
+// Types of changes reported in a diff.
+const (
+	DiffAdded   = "added"
+	DiffRemoved = "removed"
+	DiffChanged = "changed"
+)
+
+// RecordDiff represents the differences between two versions of a
+// record, or a record that was added or removed.
+type RecordDiff struct {
+	Key    string      `json:"key"`
+	Change string      `json:"change"`
+	Fields []FieldDiff `json:"fields"`
+}
+
+// FieldDiff represents a field that was added, removed, or changed.
+// Old and New are the fields in Mnemonic MARC (e.g. =650  \0$aCoal).
+type FieldDiff struct {
+	Tag       string         `json:"tag"`
+	Change    string         `json:"change"`
+	Old       string         `json:"old,omitempty"`
+	New       string         `json:"new,omitempty"`
+	SubFields []SubFieldDiff `json:"subfields,omitempty"`
+}
+
+// SubFieldDiff represents a subfield that was added, removed, or
+// changed in a field.
+type SubFieldDiff struct {
+	Code   string `json:"code"`
+	Change string `json:"change"`
+	Old    string `json:"old,omitempty"`
+	New    string `json:"new,omitempty"`
+}
+
+// DiffFiles compares the records in two uncompressed MARC binary files.
+// Records are paired by the value of the key (e.g. "001" or "035a", see
+// NewFieldFilter) and fn is called for each record that was removed from
+// or changed in the old file (in the order of the old file) and then for
+// each record added in the new file. Only the keys and the location of
+// the records are kept in memory so files larger than memory can be
+// compared. Records without a key, or with a key already used by another
+// record in the same file, cannot be paired and they are reported as
+// removed or added.
+func DiffFiles(old io.ReadSeeker, new io.ReadSeeker, key string, fn func(RecordDiff) error) error {
+	oldIndex, err := buildDiffIndex(old, key)
+	if err != nil {
+		return err
+	}
+	newIndex, err := buildDiffIndex(new, key)
+	if err != nil {
+		return err
+	}
+
+	for i, entry := range oldIndex.Entries {
+		oldRec := Record{}
+		if err := readIndexedRecord(old, entry, &oldRec); err != nil {
+			return err
+		}
+		j, ok := newIndex.Find(entry.Key)
+		if !ok || !isFirstKey(oldIndex, i) {
+			if err := fn(recordDiff(entry.Key, DiffRemoved, oldRec)); err != nil {
+				return err
+			}
+			continue
+		}
+		newRec := Record{}
+		if err := readIndexedRecord(new, newIndex.Entries[j], &newRec); err != nil {
+			return err
+		}
+		if diff := DiffRecords(entry.Key, oldRec, newRec); diff.Change != "" {
+			if err := fn(diff); err != nil {
+				return err
+			}
+		}
+	}
+
+	for j, entry := range newIndex.Entries {
+		if _, ok := oldIndex.Find(entry.Key); ok && isFirstKey(newIndex, j) {
+			continue
+		}
+		newRec := Record{}
+		if err := readIndexedRecord(new, entry, &newRec); err != nil {
+			return err
+		}
+		if err := fn(recordDiff(entry.Key, DiffAdded, newRec)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func buildDiffIndex(r io.ReadSeeker, key string) (Index, error) {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return Index{}, err
+	}
+	return BuildIndex(r, key)
+}
+
+// isFirstKey returns true if the entry at position i has a key and it is
+// the first entry in the index with that key.
+func isFirstKey(index Index, i int) bool {
+	first, ok := index.Find(index.Entries[i].Key)
+	return ok && first == i
+}
+
+// recordDiff returns the diff for a record that was added or removed.
+func recordDiff(key string, change string, r Record) RecordDiff {
+	diff := RecordDiff{Key: key, Change: change}
+	diff.Fields = append(diff.Fields, fieldDiff("LDR", change, r.Leader.String()))
+	for _, field := range r.Fields {
+		diff.Fields = append(diff.Fields, fieldDiff(field.Tag, change, field.String()))
+	}
+	return diff
+}
+
+func fieldDiff(tag string, change string, value string) FieldDiff {
+	if change == DiffAdded {
+		return FieldDiff{Tag: tag, Change: change, New: value}
+	}
+	return FieldDiff{Tag: tag, Change: change, Old: value}
+}
+
+// DiffRecords compares two versions of a record and returns the fields
+// that were added, removed, or changed. The Change of the diff is empty
+// when the records are the same. The record length and the base address
+// of data in the leader are not compared since they change with any
+// change in the record.
+func DiffRecords(key string, old Record, new Record) RecordDiff {
+	diff := RecordDiff{Key: key}
+	if comparableLeader(old.Leader) != comparableLeader(new.Leader) {
+		diff.Fields = append(diff.Fields, FieldDiff{Tag: "LDR", Change: DiffChanged, Old: old.Leader.String(), New: new.Leader.String()})
+	}
+
+	oldValues := make([]string, len(old.Fields))
+	for i, field := range old.Fields {
+		oldValues[i] = field.String()
+	}
+	newValues := make([]string, len(new.Fields))
+	for i, field := range new.Fields {
+		newValues[i] = field.String()
+	}
+
+	// Removed and added fields with the same tag next to each other in the
+	// edit script are reported as a changed field.
+	ops := diffStrings(oldValues, newValues)
+	for start := 0; start < len(ops); {
+		if ops[start].kind == '=' {
+			start++
+			continue
+		}
+		end := start
+		for end < len(ops) && ops[end].kind != '=' {
+			end++
+		}
+		diff.Fields = append(diff.Fields, pairFieldChanges(old.Fields, new.Fields, ops[start:end])...)
+		start = end
+	}
+
+	if len(diff.Fields) > 0 {
+		diff.Change = DiffChanged
+	}
+	return diff
+}
+
+func pairFieldChanges(oldFields []Field, newFields []Field, ops []diffOp) []FieldDiff {
+	diffs := []FieldDiff{}
+	paired := map[int]bool{}
+	for _, op := range ops {
+		if op.kind != '-' {
+			continue
+		}
+		oldField := oldFields[op.old]
+		change := FieldDiff{Tag: oldField.Tag, Change: DiffRemoved, Old: oldField.String()}
+		for _, other := range ops {
+			if other.kind == '+' && !paired[other.new] && newFields[other.new].Tag == oldField.Tag {
+				paired[other.new] = true
+				newField := newFields[other.new]
+				change.Change = DiffChanged
+				change.New = newField.String()
+				change.SubFields = diffSubFields(oldField, newField)
+				break
+			}
+		}
+		diffs = append(diffs, change)
+	}
+	for _, op := range ops {
+		if op.kind == '+' && !paired[op.new] {
+			newField := newFields[op.new]
+			diffs = append(diffs, FieldDiff{Tag: newField.Tag, Change: DiffAdded, New: newField.String()})
+		}
+	}
+	return diffs
+}
+
+// diffSubFields returns the subfields that changed between two versions
+// of a data field.
+func diffSubFields(old Field, new Field) []SubFieldDiff {
+	if old.IsControlField() {
+		return nil
+	}
+	oldValues := make([]string, len(old.SubFields))
+	for i, sub := range old.SubFields {
+		oldValues[i] = sub.Code + sub.Value
+	}
+	newValues := make([]string, len(new.SubFields))
+	for i, sub := range new.SubFields {
+		newValues[i] = sub.Code + sub.Value
+	}
+
+	diffs := []SubFieldDiff{}
+	ops := diffStrings(oldValues, newValues)
+	for i := 0; i < len(ops); i++ {
+		op := ops[i]
+		switch op.kind {
+		case '-':
+			sub := old.SubFields[op.old]
+			if i+1 < len(ops) && ops[i+1].kind == '+' && new.SubFields[ops[i+1].new].Code == sub.Code {
+				i++
+				diffs = append(diffs, SubFieldDiff{Code: sub.Code, Change: DiffChanged, Old: sub.Value, New: new.SubFields[ops[i].new].Value})
+			} else {
+				diffs = append(diffs, SubFieldDiff{Code: sub.Code, Change: DiffRemoved, Old: sub.Value})
+			}
+		case '+':
+			sub := new.SubFields[op.new]
+			diffs = append(diffs, SubFieldDiff{Code: sub.Code, Change: DiffAdded, New: sub.Value})
+		}
+	}
+	return diffs
+}
+
+// comparableLeader returns the leader without the record length and
+// the base address of data.
+func comparableLeader(l Leader) string {
+	raw := []byte(l.Raw())
+	if len(raw) != leaderLength {
+		return string(raw)
+	}
+	copy(raw[0:5], "     ")
+	copy(raw[offsetStart:offsetEnd], "     ")
+	return string(raw)
+}
+
+// diffOp is an operation in the edit script to go from one list of
+// values to another: '=' the value is in both lists, '-' the value was
+// removed from the old list, '+' the value was added in the new list.
+type diffOp struct {
+	kind byte
+	old  int // position in the old list ('=' and '-')
+	new  int // position in the new list ('=' and '+')
+}
+
+// diffStrings returns the edit script between two lists of values based
+// on their longest common subsequence.
+func diffStrings(a []string, b []string) []diffOp {
+	// lcs[i][j] is the length of the longest common subsequence
+	// of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := []diffOp{}
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		if a[i] == b[j] {
+			ops = append(ops, diffOp{kind: '=', old: i, new: j})
+			i++
+			j++
+		} else if lcs[i+1][j] >= lcs[i][j+1] {
+			ops = append(ops, diffOp{kind: '-', old: i})
+			i++
+		} else {
+			ops = append(ops, diffOp{kind: '+', new: j})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		ops = append(ops, diffOp{kind: '-', old: i})
+	}
+	for ; j < len(b); j++ {
+		ops = append(ops, diffOp{kind: '+', new: j})
+	}
+	return ops
+}
@@ -0,0 +1,100 @@
+package marc
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiffRecords(t *testing.T) {
+	t.Parallel()
+
+	old := setUpTestRecord("testdata/test_1a.mrc", t)
+	new := setUpTestRecord("testdata/test_1a.mrc", t)
+	new.Fields = append([]Field(nil), old.Fields...)
+
+	if diff := DiffRecords("ocm57175940", old, new); diff.Change != "" {
+		t.Errorf("expected no changes, got %v", diff)
+	}
+
+	new.Leader.SetStatus('c')
+	new.DeleteField("504", 0)
+	new.Field("650", 0).SubFields = []SubField{{Code: "a", Value: "Coal"}, {Code: "x", Value: "Testing."}, {Code: "z", Value: "United States."}}
+	new.InsertField(Field{Tag: "655", Indicator1: " ", Indicator2: "7", SubFields: []SubField{{Code: "a", Value: "Reports."}}})
+
+	want := RecordDiff{
+		Key:    "ocm57175940",
+		Change: DiffChanged,
+		Fields: []FieldDiff{
+			{Tag: "LDR", Change: DiffChanged, Old: "=LDR  01805nam a2200385 i 4500", New: "=LDR  01805cam a2200385 i 4500"},
+			{Tag: "504", Change: DiffRemoved, Old: `=504  \\$aIncludes bibliographical references.`},
+			{
+				Tag:    "650",
+				Change: DiffChanged,
+				Old:    `=650  \0$aCoal$xAnalysis.`,
+				New:    `=650  \0$aCoal$xTesting.$zUnited States.`,
+				SubFields: []SubFieldDiff{
+					{Code: "x", Change: DiffChanged, Old: "Analysis.", New: "Testing."},
+					{Code: "z", Change: DiffAdded, New: "United States."},
+				},
+			},
+			{Tag: "655", Change: DiffAdded, New: `=655  \7$aReports.`},
+		},
+	}
+	got := DiffRecords("ocm57175940", old, new)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestDiffFiles(t *testing.T) {
+	t.Parallel()
+
+	records := []Record{}
+	file := setUpTestFile("testdata/test_10.mrc", t)
+	defer file.Close()
+	m := NewMarcFile(file)
+	for m.Scan() {
+		r, err := m.Record()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		records = append(records, r)
+	}
+
+	marshal := func(recs ...Record) *bytes.Reader {
+		var buf bytes.Buffer
+		for _, r := range recs {
+			data, err := r.MarshalBinary()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			buf.Write(data)
+		}
+		return bytes.NewReader(buf.Bytes())
+	}
+
+	changed := records[2]
+	changed.Fields = append([]Field(nil), changed.Fields...)
+	changed.DeleteFields("650")
+	old := marshal(records[0], records[1], records[2])
+	new := marshal(records[0], changed, records[5])
+
+	got := []string{}
+	err := DiffFiles(old, new, "001", func(diff RecordDiff) error {
+		got = append(got, diff.Change+" "+diff.Key)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{
+		"removed " + records[1].ControlNum(),
+		"changed " + records[2].ControlNum(),
+		"added " + records[5].ControlNum(),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
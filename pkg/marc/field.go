@@ -9,8 +9,11 @@ import (
 )
 
 var (
-	ErrInvalidIndicators  = errors.New("invalid Indicators detected")
-	ErrBadSubfieldsLength = errors.New("bad SubFields length")
+	ErrInvalidIndicators   = errors.New("invalid Indicators detected")
+	ErrBadSubfieldsLength  = errors.New("bad SubFields length")
+	ErrInvalidSubfieldCode = errors.New("invalid subfield code (must be one character)")
+	ErrControlFieldData    = errors.New("control fields cannot have indicators or subfields")
+	ErrInvalidFieldValue   = errors.New("invalid value (cannot include record, field, or subfield delimiters)")
 )
 
 // Field represents a field inside a MARC record. Notice that the
@@ -161,6 +164,128 @@ func (f Field) GetSubFields(filter string) []SubField {
 	return values
 }
 
+// withoutSubFields returns a copy of the field without the subfields
+// indicated in the filter string (e.g. "xz").
+func (f Field) withoutSubFields(filter string) Field {
+	subfields := []SubField{}
+	for _, sub := range f.SubFields {
+		if !strings.Contains(filter, sub.Code) {
+			subfields = append(subfields, sub)
+		}
+	}
+	f.SubFields = subfields
+	return f
+}
+
+// nonfilingCharacters returns the number of characters to ignore at the
+// beginning of the field when sorting or matching (e.g. 4 for "The "), as
+// indicated in the nonfiling indicator of title fields.
+func (f Field) nonfilingCharacters() int {
+	ind := ""
+	switch f.Tag {
+	case "130", "630", "730", "740":
+		ind = f.Indicator1
+	case "222", "240", "242", "243", "245", "440", "830":
+		ind = f.Indicator2
+	}
+	if len(ind) != 1 || ind[0] < '0' || ind[0] > '9' {
+		return 0
+	}
+	return int(ind[0] - '0')
+}
+
+// AddSubField appends a subfield at the end of the field.
+func (f *Field) AddSubField(code string, value string) error {
+	if f.IsControlField() {
+		return ErrControlFieldData
+	}
+	if len(code) != 1 || hasDelimiters(code) {
+		return ErrInvalidSubfieldCode
+	}
+	if hasDelimiters(value) {
+		return ErrInvalidFieldValue
+	}
+	f.SubFields = append(f.SubFields, SubField{Code: code, Value: value})
+	return nil
+}
+
+// RemoveSubFields removes all the subfields with the given code and
+// returns the number of subfields removed.
+func (f *Field) RemoveSubFields(code string) int {
+	subfields := []SubField{}
+	for _, sub := range f.SubFields {
+		if sub.Code != code {
+			subfields = append(subfields, sub)
+		}
+	}
+	count := len(f.SubFields) - len(subfields)
+	f.SubFields = subfields
+	return count
+}
+
+// SetSubField sets the value of the first subfield with the given code,
+// the subfield is added at the end of the field if it does not exist.
+func (f *Field) SetSubField(code string, value string) error {
+	for i, sub := range f.SubFields {
+		if sub.Code == code {
+			if hasDelimiters(value) {
+				return ErrInvalidFieldValue
+			}
+			f.SubFields[i].Value = value
+			return nil
+		}
+	}
+	return f.AddSubField(code, value)
+}
+
+// SetIndicators sets both indicators of a data field. Each indicator
+// must be a single character (use " " for blank).
+func (f *Field) SetIndicators(ind1 string, ind2 string) error {
+	if f.IsControlField() {
+		return ErrControlFieldData
+	}
+	if len(ind1) != 1 || len(ind2) != 1 || hasDelimiters(ind1+ind2) {
+		return ErrInvalidIndicators
+	}
+	f.Indicator1 = ind1
+	f.Indicator2 = ind2
+	return nil
+}
+
+// validate makes sure the field can be serialized to MARC.
+func (f Field) validate() error {
+	if len(f.Tag) != 3 || hasDelimiters(f.Tag) {
+		return ErrInvalidTag
+	}
+	if f.IsControlField() {
+		if len(f.SubFields) > 0 {
+			return ErrControlFieldData
+		}
+		if hasDelimiters(f.Value) {
+			return ErrInvalidFieldValue
+		}
+		return nil
+	}
+	if len(f.Indicator1) > 1 || len(f.Indicator2) > 1 || hasDelimiters(f.Indicator1+f.Indicator2) {
+		return ErrInvalidIndicators
+	}
+	for _, sub := range f.SubFields {
+		if len(sub.Code) != 1 || hasDelimiters(sub.Code) {
+			return ErrInvalidSubfieldCode
+		}
+		if hasDelimiters(sub.Value) {
+			return ErrInvalidFieldValue
+		}
+	}
+	return nil
+}
+
+// hasDelimiters returns true if the value includes any of the MARC
+// delimiters, which would make the record impossible to serialize.
+func hasDelimiters(value string) bool {
+	return strings.ContainsAny(value, string([]byte{rt, ft, st}))
+}
+
 func formatIndicator(value string) string {
 	if value == " " {
 		return "\\"
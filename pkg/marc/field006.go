@@ -0,0 +1,80 @@
+package marc
+
+import "errors"
+
+var ErrBadField006Len = errors.New("006 field is not 18 characters long")
+
+// Field006 represents the decoded 006 (additional material
+// characteristics) of a bibliographic record. The material specific
+// elements are the same as in 008/18-34.
+// See https://www.loc.gov/marc/bibliographic/bd006.html
+type Field006 struct {
+	FormOfMaterial   FixedValue // 00
+	MaterialElements            // 01-17
+	Elements         []FixedElement
+}
+
+var field006Specs = []fixedSpec{
+	{start: 0, end: 1, name: "Form of material", codes: formOfMaterialCodes},
+}
+
+// NewField006 decodes the value of an 006 field using the material
+// configuration indicated in 006/00. Values shorter than 18 characters
+// are decoded as much as possible and ErrBadField006Len is returned.
+func NewField006(value string) (Field006, error) {
+	var err error
+	if len(value) != 18 {
+		err = ErrBadField006Len
+	}
+
+	elements := fixedElements{}
+	decodeFixed(value, 0, field006Specs, &elements)
+	material := ""
+	if len(value) > 0 {
+		material = formOfMaterialType(value[0])
+	}
+
+	f := Field006{
+		FormOfMaterial:   elements.get("Form of material"),
+		MaterialElements: decodeMaterial(value, 1, material, &elements),
+	}
+	f.Elements = elements.list
+	return f, err
+}
+
+// Fields006 returns the decoded 006 fields of the record. The error
+// returned is the first error found while decoding them.
+func (r Record) Fields006() ([]Field006, error) {
+	var firstErr error
+	fields := []Field006{}
+	for _, field := range r.FieldsByTag("006") {
+		f, err := NewField006(field.Value)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		fields = append(fields, f)
+	}
+	return fields, firstErr
+}
+
+// formOfMaterialType returns the material configuration for the form
+// of material in 006/00.
+func formOfMaterialType(form byte) string {
+	switch form {
+	case 'a', 't':
+		return MaterialBooks
+	case 'm':
+		return MaterialComputerFiles
+	case 'e', 'f':
+		return MaterialMaps
+	case 'c', 'd', 'i', 'j':
+		return MaterialMusic
+	case 's':
+		return MaterialContinuingResources
+	case 'g', 'k', 'o', 'r':
+		return MaterialVisualMaterials
+	case 'p':
+		return MaterialMixedMaterials
+	}
+	return ""
+}
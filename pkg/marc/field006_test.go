@@ -0,0 +1,65 @@
+package marc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFields006(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	fields, err := record.Fields006()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fields) != 1 {
+		t.Fatalf("expected 1 field, got %d", len(fields))
+	}
+
+	f := fields[0]
+	want := FixedValue{Code: "m", Label: "Computer file/Electronic resource"}
+	if !cmp.Equal(f.FormOfMaterial, want) {
+		t.Errorf("expected %v, got %v", want, f.FormOfMaterial)
+	}
+	if f.Type != MaterialComputerFiles || f.ComputerFiles == nil {
+		t.Fatalf("expected the computer files configuration, got %v", f.MaterialElements)
+	}
+	want = FixedValue{Code: "d", Label: "Document"}
+	if !cmp.Equal(f.ComputerFiles.TypeOfFile, want) {
+		t.Errorf("expected %v, got %v", want, f.ComputerFiles.TypeOfFile)
+	}
+	if f.Elements[3].String() != "09 Type of computer file: d (Document)" {
+		t.Errorf("unexpected element %q", f.Elements[3].String())
+	}
+}
+
+func TestNewField006(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		material string
+		err      error
+	}{
+		{name: "books", value: "a     b    001 0  ", material: MaterialBooks},
+		{name: "serial", value: "sar         0   0 ", material: MaterialContinuingResources},
+		{name: "sound recording", value: "jmun         n    ", material: MaterialMusic},
+		{name: "short", value: "m    ", material: MaterialComputerFiles, err: ErrBadField006Len},
+		{name: "unknown", value: "x                 ", material: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewField006(tt.value)
+			if err != tt.err {
+				t.Errorf("expected error %v, got %v", tt.err, err)
+			}
+			if f.Type != tt.material {
+				t.Errorf("expected %q, got %q", tt.material, f.Type)
+			}
+		})
+	}
+}
@@ -0,0 +1,295 @@
+package marc
+
+import "errors"
+
+var (
+	ErrBadField007Len      = errors.New("007 field is too short for its category of material")
+	ErrBadField007Category = errors.New("007 field has an invalid category of material")
+)
+
+// MapDescription are the 007 elements for maps (007/00 = a).
+type MapDescription struct {
+	Color              FixedValue
+	PhysicalMedium     FixedValue
+	TypeOfReproduction FixedValue
+	ProductionDetails  FixedValue
+	PositiveNegative   FixedValue
+}
+
+// ElectronicResourceDescription are the 007 elements for electronic
+// resources (007/00 = c).
+type ElectronicResourceDescription struct {
+	Color               FixedValue
+	Dimensions          FixedValue
+	Sound               FixedValue
+	ImageBitDepth       FixedValue
+	FileFormats         FixedValue
+	QualityAssurance    FixedValue
+	Antecedent          FixedValue
+	Compression         FixedValue
+	ReformattingQuality FixedValue
+}
+
+// SoundRecordingDescription are the 007 elements for sound recordings
+// (007/00 = s).
+type SoundRecordingDescription struct {
+	Speed                   FixedValue
+	PlaybackChannels        FixedValue
+	Groove                  FixedValue
+	Dimensions              FixedValue
+	TapeWidth               FixedValue
+	TapeConfiguration       FixedValue
+	KindOfDisc              FixedValue
+	KindOfMaterial          FixedValue
+	KindOfCutting           FixedValue
+	PlaybackCharacteristics FixedValue
+	CaptureTechnique        FixedValue
+}
+
+// VideorecordingDescription are the 007 elements for videorecordings
+// (007/00 = v).
+type VideorecordingDescription struct {
+	Color            FixedValue
+	Format           FixedValue
+	SoundOnMedium    FixedValue
+	MediumForSound   FixedValue
+	Dimensions       FixedValue
+	PlaybackChannels FixedValue
+}
+
+// Field007 represents a decoded 007 (physical description fixed field).
+// The elements of every category of material are available in Elements,
+// the typed description is set only for maps, electronic resources,
+// sound recordings, and videorecordings.
+// See https://www.loc.gov/marc/bibliographic/bd007.html
+type Field007 struct {
+	Category           FixedValue // 00
+	SpecificMaterial   FixedValue // 01
+	Map                *MapDescription
+	ElectronicResource *ElectronicResourceDescription
+	SoundRecording     *SoundRecordingDescription
+	Videorecording     *VideorecordingDescription
+	Elements           []FixedElement
+}
+
+var field007CategorySpec = fixedSpec{start: 0, end: 1, name: "Category of material", codes: categoryOfMaterialCodes}
+
+// field007Specs are the elements after 007/00 for each category of material.
+var field007Specs = map[string][]fixedSpec{
+	"a": {
+		{start: 1, end: 2, name: "Specific material designation", codes: mapDesignationCodes},
+		{start: 3, end: 4, name: "Color", codes: colorCodes},
+		{start: 4, end: 5, name: "Physical medium", codes: physicalMediumCodes},
+		{start: 5, end: 6, name: "Type of reproduction", codes: typeOfReproductionCodes},
+		{start: 6, end: 7, name: "Production/reproduction details", codes: mapProductionDetailsCodes},
+		{start: 7, end: 8, name: "Positive/negative aspect", codes: positiveNegativeCodes},
+	},
+	"c": {
+		{start: 1, end: 2, name: "Specific material designation", codes: electronicDesignationCodes},
+		{start: 3, end: 4, name: "Color", codes: colorCodes},
+		{start: 4, end: 5, name: "Dimensions", codes: electronicDimensionsCodes},
+		{start: 5, end: 6, name: "Sound", codes: electronicSoundCodes},
+		{start: 6, end: 9, name: "Image bit depth", codes: imageBitDepthCodes, open: true},
+		{start: 9, end: 10, name: "File formats", codes: fileFormatsCodes},
+		{start: 10, end: 11, name: "Quality assurance targets", codes: qualityAssuranceCodes},
+		{start: 11, end: 12, name: "Antecedent/source", codes: antecedentCodes},
+		{start: 12, end: 13, name: "Level of compression", codes: compressionCodes},
+		{start: 13, end: 14, name: "Reformatting quality", codes: reformattingQualityCodes},
+	},
+	"d": {
+		{start: 1, end: 2, name: "Specific material designation", codes: globeDesignationCodes},
+		{start: 3, end: 4, name: "Color", codes: colorCodes},
+		{start: 4, end: 5, name: "Physical medium", codes: physicalMediumCodes},
+		{start: 5, end: 6, name: "Type of reproduction", codes: typeOfReproductionCodes},
+	},
+	"f": {
+		{start: 1, end: 2, name: "Specific material designation", codes: tactileDesignationCodes},
+		{start: 3, end: 5, name: "Class of braille writing", codes: brailleClassCodes, multi: true},
+		{start: 5, end: 6, name: "Level of contraction", codes: contractionCodes},
+		{start: 6, end: 9, name: "Braille music format", codes: brailleMusicFormatCodes, multi: true},
+		{start: 9, end: 10, name: "Special physical characteristics", codes: tactileSpecialCodes},
+	},
+	"g": {
+		{start: 1, end: 2, name: "Specific material designation", codes: projectedDesignationCodes},
+		{start: 3, end: 4, name: "Color", codes: colorCodes},
+		{start: 4, end: 5, name: "Base of emulsion", codes: emulsionBaseCodes},
+		{start: 5, end: 6, name: "Sound on medium or separate", codes: soundOnMediumCodes},
+		{start: 6, end: 7, name: "Medium for sound", codes: mediumForSoundCodes},
+		{start: 7, end: 8, name: "Dimensions", codes: projectedDimensionsCodes},
+		{start: 8, end: 9, name: "Secondary support material", codes: secondarySupportCodes},
+	},
+	"h": {
+		{start: 1, end: 2, name: "Specific material designation", codes: microformDesignationCodes},
+		{start: 3, end: 4, name: "Positive/negative aspect", codes: positiveNegativeCodes},
+		{start: 4, end: 5, name: "Dimensions", codes: microformDimensionsCodes},
+		{start: 5, end: 6, name: "Reduction ratio range", codes: reductionRatioRangeCodes},
+		{start: 6, end: 9, name: "Reduction ratio"},
+		{start: 9, end: 10, name: "Color", codes: colorCodes},
+		{start: 10, end: 11, name: "Emulsion on film", codes: emulsionCodes},
+		{start: 11, end: 12, name: "Generation", codes: microformGenerationCodes},
+		{start: 12, end: 13, name: "Base of film", codes: filmBaseCodes},
+	},
+	"k": {
+		{start: 1, end: 2, name: "Specific material designation", codes: nonprojectedDesignationCodes},
+		{start: 3, end: 4, name: "Color", codes: colorCodes},
+		{start: 4, end: 5, name: "Primary support material", codes: supportMaterialCodes},
+		{start: 5, end: 6, name: "Secondary support material", codes: withCode(supportMaterialCodes, " ", "No secondary support")},
+	},
+	"m": {
+		{start: 1, end: 2, name: "Specific material designation", codes: motionPictureDesignationCodes},
+		{start: 3, end: 4, name: "Color", codes: colorCodes},
+		{start: 4, end: 5, name: "Motion picture presentation format", codes: presentationFormatCodes},
+		{start: 5, end: 6, name: "Sound on medium or separate", codes: soundOnMediumCodes},
+		{start: 6, end: 7, name: "Medium for sound", codes: mediumForSoundCodes},
+		{start: 7, end: 8, name: "Dimensions", codes: motionPictureDimensionsCodes},
+		{start: 8, end: 9, name: "Configuration of playback channels", codes: playbackChannelsCodes},
+		{start: 9, end: 10, name: "Production elements", codes: productionElementsCodes},
+		{start: 10, end: 11, name: "Positive/negative aspect", codes: positiveNegativeCodes},
+		{start: 11, end: 12, name: "Generation", codes: motionPictureGenerationCodes},
+		{start: 12, end: 13, name: "Base of film", codes: filmBaseCodes},
+		{start: 13, end: 14, name: "Refined categories of color"},
+		{start: 14, end: 15, name: "Kind of color stock or print"},
+		{start: 15, end: 16, name: "Deterioration stage"},
+		{start: 16, end: 17, name: "Completeness", codes: completenessCodes},
+		{start: 17, end: 23, name: "Film inspection date"},
+	},
+	"o": {
+		{start: 1, end: 2, name: "Specific material designation", codes: unspecifiedDesignationCodes},
+	},
+	"q": {
+		{start: 1, end: 2, name: "Specific material designation", codes: unspecifiedDesignationCodes},
+	},
+	"r": {
+		{start: 1, end: 2, name: "Specific material designation", codes: unspecifiedDesignationCodes},
+		{start: 3, end: 4, name: "Altitude of sensor", codes: sensorAltitudeCodes},
+		{start: 4, end: 5, name: "Attitude of sensor", codes: sensorAttitudeCodes},
+		{start: 5, end: 6, name: "Cloud cover", codes: cloudCoverCodes},
+		{start: 6, end: 7, name: "Platform construction type"},
+		{start: 7, end: 8, name: "Platform use category"},
+		{start: 8, end: 9, name: "Sensor type", codes: sensorTypeCodes},
+		{start: 9, end: 11, name: "Data type"},
+	},
+	"s": {
+		{start: 1, end: 2, name: "Specific material designation", codes: soundDesignationCodes},
+		{start: 3, end: 4, name: "Speed", codes: soundSpeedCodes},
+		{start: 4, end: 5, name: "Configuration of playback channels", codes: playbackChannelsCodes},
+		{start: 5, end: 6, name: "Groove width/groove pitch", codes: grooveCodes},
+		{start: 6, end: 7, name: "Dimensions", codes: soundDimensionsCodes},
+		{start: 7, end: 8, name: "Tape width", codes: tapeWidthCodes},
+		{start: 8, end: 9, name: "Tape configuration", codes: tapeConfigurationCodes},
+		{start: 9, end: 10, name: "Kind of disc, cylinder, or tape", codes: kindOfDiscCodes},
+		{start: 10, end: 11, name: "Kind of material", codes: kindOfSoundMaterialCodes},
+		{start: 11, end: 12, name: "Kind of cutting", codes: kindOfCuttingCodes},
+		{start: 12, end: 13, name: "Special playback characteristics", codes: playbackCharacteristicsCodes},
+		{start: 13, end: 14, name: "Capture and storage technique", codes: captureTechniqueCodes},
+	},
+	"t": {
+		{start: 1, end: 2, name: "Specific material designation", codes: textDesignationCodes},
+	},
+	"v": {
+		{start: 1, end: 2, name: "Specific material designation", codes: videoDesignationCodes},
+		{start: 3, end: 4, name: "Color", codes: colorCodes},
+		{start: 4, end: 5, name: "Videorecording format", codes: videoFormatCodes},
+		{start: 5, end: 6, name: "Sound on medium or separate", codes: soundOnMediumCodes},
+		{start: 6, end: 7, name: "Medium for sound", codes: mediumForSoundCodes},
+		{start: 7, end: 8, name: "Dimensions", codes: videoDimensionsCodes},
+		{start: 8, end: 9, name: "Configuration of playback channels", codes: playbackChannelsCodes},
+	},
+	"z": {
+		{start: 1, end: 2, name: "Specific material designation", codes: unspecifiedMaterialCodes},
+	},
+}
+
+// NewField007 decodes the value of an 007 field using the category of
+// material in 007/00. Values shorter than required by the category are
+// decoded as much as possible and ErrBadField007Len is returned.
+func NewField007(value string) (Field007, error) {
+	elements := fixedElements{}
+	decodeFixed(value, 0, []fixedSpec{field007CategorySpec}, &elements)
+	f := Field007{Category: elements.get("Category of material")}
+
+	specs, ok := field007Specs[f.Category.Code]
+	if !ok {
+		f.Elements = elements.list
+		return f, ErrBadField007Category
+	}
+	decodeFixed(value, 0, specs, &elements)
+	f.SpecificMaterial = elements.get("Specific material designation")
+	f.Elements = elements.list
+
+	get := elements.get
+	switch f.Category.Code {
+	case "a":
+		f.Map = &MapDescription{
+			Color:              get("Color"),
+			PhysicalMedium:     get("Physical medium"),
+			TypeOfReproduction: get("Type of reproduction"),
+			ProductionDetails:  get("Production/reproduction details"),
+			PositiveNegative:   get("Positive/negative aspect"),
+		}
+	case "c":
+		f.ElectronicResource = &ElectronicResourceDescription{
+			Color:               get("Color"),
+			Dimensions:          get("Dimensions"),
+			Sound:               get("Sound"),
+			ImageBitDepth:       get("Image bit depth"),
+			FileFormats:         get("File formats"),
+			QualityAssurance:    get("Quality assurance targets"),
+			Antecedent:          get("Antecedent/source"),
+			Compression:         get("Level of compression"),
+			ReformattingQuality: get("Reformatting quality"),
+		}
+	case "s":
+		f.SoundRecording = &SoundRecordingDescription{
+			Speed:                   get("Speed"),
+			PlaybackChannels:        get("Configuration of playback channels"),
+			Groove:                  get("Groove width/groove pitch"),
+			Dimensions:              get("Dimensions"),
+			TapeWidth:               get("Tape width"),
+			TapeConfiguration:       get("Tape configuration"),
+			KindOfDisc:              get("Kind of disc, cylinder, or tape"),
+			KindOfMaterial:          get("Kind of material"),
+			KindOfCutting:           get("Kind of cutting"),
+			PlaybackCharacteristics: get("Special playback characteristics"),
+			CaptureTechnique:        get("Capture and storage technique"),
+		}
+	case "v":
+		f.Videorecording = &VideorecordingDescription{
+			Color:            get("Color"),
+			Format:           get("Videorecording format"),
+			SoundOnMedium:    get("Sound on medium or separate"),
+			MediumForSound:   get("Medium for sound"),
+			Dimensions:       get("Dimensions"),
+			PlaybackChannels: get("Configuration of playback channels"),
+		}
+	}
+
+	if len(value) < specs[len(specs)-1].end {
+		return f, ErrBadField007Len
+	}
+	return f, nil
+}
+
+// Fields007 returns the decoded 007 fields of the record. The error
+// returned is the first error found while decoding them.
+func (r Record) Fields007() ([]Field007, error) {
+	var firstErr error
+	fields := []Field007{}
+	for _, field := range r.FieldsByTag("007") {
+		f, err := NewField007(field.Value)
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+		fields = append(fields, f)
+	}
+	return fields, firstErr
+}
+
+// withCode returns a copy of codes with an additional code.
+func withCode(codes map[string]string, code, label string) map[string]string {
+	result := map[string]string{code: label}
+	for k, v := range codes {
+		result[k] = v
+	}
+	return result
+}
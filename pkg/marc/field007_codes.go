@@ -0,0 +1,830 @@
+package marc
+
+// Code values for the physical description fixed field (007).
+// See https://www.loc.gov/marc/bibliographic/bd007.html
+
+var (
+	categoryOfMaterialCodes = map[string]string{
+		"a": "Map",
+		"c": "Electronic resource",
+		"d": "Globe",
+		"f": "Tactile material",
+		"g": "Projected graphic",
+		"h": "Microform",
+		"k": "Nonprojected graphic",
+		"m": "Motion picture",
+		"o": "Kit",
+		"q": "Notated music",
+		"r": "Remote-sensing image",
+		"s": "Sound recording",
+		"t": "Text",
+		"v": "Videorecording",
+		"z": "Unspecified",
+	}
+
+	colorCodes = map[string]string{
+		"a": "One color",
+		"b": "Black-and-white",
+		"c": "Multicolored",
+		"g": "Gray scale",
+		"h": "Hand colored",
+		"m": "Mixed",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	physicalMediumCodes = map[string]string{
+		"a": "Paper",
+		"b": "Wood",
+		"c": "Stone",
+		"d": "Metal",
+		"e": "Synthetic",
+		"f": "Skin",
+		"g": "Textiles",
+		"i": "Plastic",
+		"j": "Glass",
+		"l": "Vinyl",
+		"n": "Vellum",
+		"p": "Plaster",
+		"q": "Flexible base photographic, positive",
+		"r": "Flexible base photographic, negative",
+		"s": "Non-flexible base photographic, positive",
+		"t": "Non-flexible base photographic, negative",
+		"u": "Unknown",
+		"v": "Leather",
+		"w": "Parchment",
+		"y": "Other photographic medium",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	typeOfReproductionCodes = map[string]string{
+		"f": "Facsimile",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	positiveNegativeCodes = map[string]string{
+		"a": "Positive",
+		"b": "Negative",
+		"m": "Mixed polarity",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	soundOnMediumCodes = map[string]string{
+		" ": "No sound (silent)",
+		"a": "Sound on medium",
+		"b": "Sound separate from medium",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	mediumForSoundCodes = map[string]string{
+		" ": "No sound (silent)",
+		"a": "Optical sound track on motion picture film",
+		"b": "Magnetic sound track on motion picture film",
+		"c": "Magnetic audio tape in cartridge",
+		"d": "Sound disc",
+		"e": "Magnetic audio tape on reel",
+		"f": "Magnetic audio tape in cassette",
+		"g": "Optical and magnetic sound track on motion picture film",
+		"h": "Videotape",
+		"i": "Videodisc",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	playbackChannelsCodes = map[string]string{
+		"k": "Mixed",
+		"m": "Monaural",
+		"n": "Not applicable",
+		"q": "Quadraphonic, multichannel, or surround",
+		"s": "Stereophonic",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	unspecifiedDesignationCodes = map[string]string{
+		"u": "Unspecified",
+		"|": noAttempt,
+	}
+
+	// Map (007/00 = a)
+	mapDesignationCodes = map[string]string{
+		"d": "Atlas",
+		"g": "Diagram",
+		"j": "Map",
+		"k": "Profile",
+		"q": "Model",
+		"r": "Remote-sensing image",
+		"s": "Section",
+		"u": "Unspecified",
+		"y": "View",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	mapProductionDetailsCodes = map[string]string{
+		"a": "Photocopy, blueline print",
+		"b": "Photocopy",
+		"c": "Pre-production",
+		"d": "Film",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Electronic resource (007/00 = c)
+	electronicDesignationCodes = map[string]string{
+		"a": "Tape cartridge",
+		"b": "Chip cartridge",
+		"c": "Computer optical disc cartridge",
+		"d": "Computer disc, type unspecified",
+		"e": "Computer disc cartridge, type unspecified",
+		"f": "Tape cassette",
+		"h": "Tape reel",
+		"j": "Magnetic disk",
+		"k": "Computer card",
+		"m": "Magneto-optical disc",
+		"o": "Optical disc",
+		"r": "Remote",
+		"s": "Standalone device",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	electronicDimensionsCodes = map[string]string{
+		"a": "3 1/2 in.",
+		"e": "12 in.",
+		"g": "4 3/4 in. or 12 cm.",
+		"i": "1 1/8 x 2 3/8 in.",
+		"j": "3 7/8 x 2 1/2 in.",
+		"n": "Not applicable",
+		"o": "5 1/4 in.",
+		"u": "Unknown",
+		"v": "8 in.",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	electronicSoundCodes = map[string]string{
+		" ": "No sound (silent)",
+		"a": "Sound",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	imageBitDepthCodes = map[string]string{
+		"mmm": "Multiple",
+		"nnn": "Not applicable",
+		"---": "Unknown",
+		"|||": noAttempt,
+	}
+
+	fileFormatsCodes = map[string]string{
+		"a": "One file format",
+		"m": "Multiple file formats",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	qualityAssuranceCodes = map[string]string{
+		"a": "Absent",
+		"n": "Not applicable",
+		"p": "Present",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	antecedentCodes = map[string]string{
+		"a": "File reproduced from original",
+		"b": "File reproduced from microform",
+		"c": "File reproduced from an electronic resource",
+		"d": "File reproduced from an intermediate (not microform)",
+		"m": "Mixed",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	compressionCodes = map[string]string{
+		"a": "Uncompressed",
+		"b": "Lossless",
+		"d": "Lossy",
+		"m": "Mixed",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	reformattingQualityCodes = map[string]string{
+		"a": "Access",
+		"n": "Not applicable",
+		"p": "Preservation",
+		"r": "Replacement",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	// Globe (007/00 = d)
+	globeDesignationCodes = map[string]string{
+		"a": "Celestial globe",
+		"b": "Planetary or lunar globe",
+		"c": "Terrestrial globe",
+		"e": "Earth moon globe",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Tactile material (007/00 = f)
+	tactileDesignationCodes = map[string]string{
+		"a": "Moon",
+		"b": "Braille",
+		"c": "Combination",
+		"d": "Tactile, with no writing system",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	brailleClassCodes = map[string]string{
+		" ": "No specified class of braille writing",
+		"a": "Literary braille",
+		"b": "Format code braille",
+		"c": "Mathematics and scientific braille",
+		"d": "Computer braille",
+		"e": "Music braille",
+		"m": "Multiple braille types",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	contractionCodes = map[string]string{
+		"a": "Uncontracted",
+		"b": "Contracted",
+		"m": "Combination",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	brailleMusicFormatCodes = map[string]string{
+		" ": "No specified braille music format",
+		"a": "Bar over bar",
+		"b": "Bar by bar",
+		"c": "Line over line",
+		"d": "Paragraph",
+		"e": "Single line",
+		"f": "Section by section",
+		"g": "Line by line",
+		"h": "Open score",
+		"i": "Spanner short form scoring",
+		"j": "Short form scoring",
+		"k": "Outline",
+		"l": "Vertical score",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	tactileSpecialCodes = map[string]string{
+		"a": "Print/braille",
+		"b": "Jumbo or enlarged braille",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Projected graphic (007/00 = g)
+	projectedDesignationCodes = map[string]string{
+		"c": "Filmstrip cartridge",
+		"d": "Filmslip",
+		"f": "Filmstrip, type unspecified",
+		"o": "Filmstrip roll",
+		"s": "Slide",
+		"t": "Transparency",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	emulsionBaseCodes = map[string]string{
+		"d": "Glass",
+		"e": "Synthetic",
+		"j": "Safety film",
+		"k": "Film base, other than safety film",
+		"m": "Mixed collection",
+		"o": "Paper",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	projectedDimensionsCodes = map[string]string{
+		"a": "Standard 8 mm. film width",
+		"b": "Super 8 mm./single 8 mm. film width",
+		"c": "9.5 mm. film width",
+		"d": "16 mm. film width",
+		"e": "28 mm. film width",
+		"f": "35 mm. film width",
+		"g": "70 mm. film width",
+		"j": "2x2 in. or 5x5 cm.",
+		"k": "2 1/4 x 2 1/4 in. or 6x6 cm.",
+		"s": "4x5 in. or 10x13 cm.",
+		"t": "5x7 in. or 13x18 cm.",
+		"u": "Unknown",
+		"v": "8x10 in. or 21x26 cm.",
+		"w": "9x9 in. or 23x23 cm.",
+		"x": "10x10 in. or 26x26 cm.",
+		"y": "7x7 in. or 18x18 cm.",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	secondarySupportCodes = map[string]string{
+		" ": "No secondary support",
+		"c": "Cardboard",
+		"d": "Glass",
+		"e": "Synthetic",
+		"h": "Metal",
+		"j": "Metal and glass",
+		"k": "Synthetic and glass",
+		"m": "Mixed collection",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Microform (007/00 = h)
+	microformDesignationCodes = map[string]string{
+		"a": "Aperture card",
+		"b": "Microfilm cartridge",
+		"c": "Microfilm cassette",
+		"d": "Microfilm reel",
+		"e": "Microfiche",
+		"f": "Microfiche cassette",
+		"g": "Microopaque",
+		"h": "Microfilm slip",
+		"j": "Microfilm roll",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	microformDimensionsCodes = map[string]string{
+		"a": "8 mm.",
+		"d": "16 mm.",
+		"f": "35 mm.",
+		"g": "70 mm.",
+		"h": "105 mm.",
+		"l": "3x5 in. or 8x13 cm.",
+		"m": "4x6 in. or 11x15 cm.",
+		"o": "6x9 in. or 16x23 cm.",
+		"p": "3 1/4 x 7 3/8 in. or 9x19 cm.",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	reductionRatioRangeCodes = map[string]string{
+		"a": "Low reduction ratio",
+		"b": "Normal reduction",
+		"c": "High reduction",
+		"d": "Very high reduction",
+		"e": "Ultra high reduction",
+		"u": "Unknown",
+		"v": "Reduction rate varies",
+		"|": noAttempt,
+	}
+
+	emulsionCodes = map[string]string{
+		"a": "Silver halide",
+		"b": "Diazo",
+		"c": "Vesicular",
+		"m": "Mixed emulsion",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	microformGenerationCodes = map[string]string{
+		"a": "First generation (master)",
+		"b": "Printing master",
+		"c": "Service copy",
+		"m": "Mixed generation",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	filmBaseCodes = map[string]string{
+		"a": "Safety base, undetermined",
+		"c": "Safety base, acetate undetermined",
+		"d": "Safety base, diacetate",
+		"i": "Nitrate base",
+		"m": "Mixed base (nitrate and safety)",
+		"n": "Not applicable",
+		"p": "Safety base, polyester",
+		"r": "Safety base, mixed",
+		"t": "Safety base, triacetate",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Nonprojected graphic (007/00 = k)
+	nonprojectedDesignationCodes = map[string]string{
+		"a": "Activity card",
+		"c": "Collage",
+		"d": "Drawing",
+		"e": "Painting",
+		"f": "Photomechanical print",
+		"g": "Photonegative",
+		"h": "Photoprint",
+		"i": "Picture",
+		"j": "Print",
+		"k": "Poster",
+		"l": "Technical drawing",
+		"n": "Chart",
+		"o": "Flash card",
+		"p": "Postcard",
+		"q": "Icon",
+		"r": "Radiograph",
+		"s": "Study print",
+		"u": "Unspecified",
+		"v": "Photograph, type unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	supportMaterialCodes = map[string]string{
+		"a": "Canvas",
+		"b": "Bristol board",
+		"c": "Cardboard/illustration board",
+		"d": "Glass",
+		"e": "Synthetic",
+		"f": "Skin",
+		"g": "Textile",
+		"h": "Metal",
+		"i": "Plastic",
+		"l": "Vinyl",
+		"m": "Mixed collection",
+		"n": "Vellum",
+		"o": "Paper",
+		"p": "Plaster",
+		"q": "Hardboard",
+		"r": "Porcelain",
+		"s": "Stone",
+		"t": "Wood",
+		"u": "Unknown",
+		"v": "Leather",
+		"w": "Parchment",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Motion picture (007/00 = m)
+	motionPictureDesignationCodes = map[string]string{
+		"c": "Film cartridge",
+		"f": "Film cassette",
+		"o": "Film roll",
+		"r": "Film reel",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	presentationFormatCodes = map[string]string{
+		"a": "Standard sound aperture (reduced frame)",
+		"b": "Nonanamorphic (wide-screen)",
+		"c": "3D",
+		"d": "Anamorphic (wide-screen)",
+		"e": "Other wide-screen format",
+		"f": "Standard silent aperture (full frame)",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	motionPictureDimensionsCodes = map[string]string{
+		"a": "Standard 8 mm.",
+		"b": "Super 8 mm./single 8 mm.",
+		"c": "9.5 mm.",
+		"d": "16 mm.",
+		"e": "28 mm.",
+		"f": "35 mm.",
+		"g": "70 mm.",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	productionElementsCodes = map[string]string{
+		"a": "Workprint",
+		"b": "Trims",
+		"c": "Outtakes",
+		"d": "Rushes",
+		"e": "Mixing tracks",
+		"f": "Title bands/inter-title rolls",
+		"g": "Production rolls",
+		"n": "Not applicable",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	motionPictureGenerationCodes = map[string]string{
+		"d": "Duplicate",
+		"e": "Master",
+		"o": "Original",
+		"r": "Reference print/viewing copy",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	completenessCodes = map[string]string{
+		"c": "Complete",
+		"i": "Incomplete",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	// Remote-sensing image (007/00 = r)
+	sensorAltitudeCodes = map[string]string{
+		"a": "Surface",
+		"b": "Airborne",
+		"c": "Spaceborne",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	sensorAttitudeCodes = map[string]string{
+		"a": "Low oblique",
+		"b": "High oblique",
+		"c": "Vertical",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	cloudCoverCodes = map[string]string{
+		"0": "0-9%",
+		"1": "10-19%",
+		"2": "20-29%",
+		"3": "30-39%",
+		"4": "40-49%",
+		"5": "50-59%",
+		"6": "60-69%",
+		"7": "70-79%",
+		"8": "80-89%",
+		"9": "90-100%",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	sensorTypeCodes = map[string]string{
+		"a": "Active",
+		"b": "Passive",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Sound recording (007/00 = s)
+	soundDesignationCodes = map[string]string{
+		"b": "Belt",
+		"d": "Sound disc",
+		"e": "Cylinder",
+		"g": "Sound cartridge",
+		"i": "Sound-track film",
+		"q": "Roll",
+		"r": "Remote",
+		"s": "Sound cassette",
+		"t": "Sound-tape reel",
+		"u": "Unspecified",
+		"w": "Wire recording",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	soundSpeedCodes = map[string]string{
+		"a": "16 rpm",
+		"b": "33 1/3 rpm",
+		"c": "45 rpm",
+		"d": "78 rpm",
+		"e": "8 rpm",
+		"f": "1.4 m. per second",
+		"h": "120 rpm",
+		"i": "160 rpm",
+		"k": "15/16 ips",
+		"l": "1 7/8 ips",
+		"m": "3 3/4 ips",
+		"n": "Not applicable",
+		"o": "7 1/2 ips",
+		"p": "15 ips",
+		"r": "30 ips",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	grooveCodes = map[string]string{
+		"m": "Microgroove/fine",
+		"n": "Not applicable",
+		"s": "Coarse/standard",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	soundDimensionsCodes = map[string]string{
+		"a": "3 in. diameter",
+		"b": "5 in. diameter",
+		"c": "7 in. diameter",
+		"d": "10 in. diameter",
+		"e": "12 in. diameter",
+		"f": "16 in. diameter",
+		"g": "4 3/4 in. or 12 cm. diameter",
+		"j": "3 7/8 x 2 1/2 in.",
+		"n": "Not applicable",
+		"o": "5 1/4 x 3 7/8 in.",
+		"s": "2 3/4 x 4 in.",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	tapeWidthCodes = map[string]string{
+		"l": "1/8 in.",
+		"m": "1/4 in.",
+		"n": "Not applicable",
+		"o": "1/2 in.",
+		"p": "1 in.",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	tapeConfigurationCodes = map[string]string{
+		"a": "Full (1) track",
+		"b": "Half (2) track",
+		"c": "Quarter (4) track",
+		"d": "Eight track",
+		"e": "Twelve track",
+		"f": "Sixteen track",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	kindOfDiscCodes = map[string]string{
+		"a": "Master tape",
+		"b": "Tape duplication master",
+		"d": "Disc master (negative)",
+		"i": "Instantaneous (recorded on the spot)",
+		"m": "Mass produced",
+		"n": "Not applicable",
+		"r": "Mother (positive)",
+		"s": "Stamper (negative)",
+		"t": "Test pressing",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	kindOfSoundMaterialCodes = map[string]string{
+		"a": "Lacquer coating",
+		"b": "Cellulose nitrate",
+		"c": "Acetate tape with ferrous oxide",
+		"g": "Glass with lacquer",
+		"i": "Aluminum with lacquer",
+		"l": "Metal",
+		"m": "Plastic with metal",
+		"n": "Not applicable",
+		"p": "Plastic",
+		"r": "Paper with lacquer or ferrous oxide",
+		"s": "Shellac",
+		"u": "Unknown",
+		"w": "Wax",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	kindOfCuttingCodes = map[string]string{
+		"h": "Hill-and-dale cutting",
+		"l": "Lateral or combined cutting",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+
+	playbackCharacteristicsCodes = map[string]string{
+		"a": "NAB standard",
+		"b": "CCIR standard",
+		"c": "Dolby-B encoded",
+		"d": "dbx encoded",
+		"e": "Digital recording",
+		"f": "Dolby-A encoded",
+		"g": "Dolby-C encoded",
+		"h": "CX encoded",
+		"n": "Not applicable",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	captureTechniqueCodes = map[string]string{
+		"a": "Acoustical capture, direct storage",
+		"b": "Direct storage, not acoustical",
+		"d": "Digital storage",
+		"e": "Analog electrical storage",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Text (007/00 = t)
+	textDesignationCodes = map[string]string{
+		"a": "Regular print",
+		"b": "Large print",
+		"c": "Braille",
+		"d": "Loose-leaf",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Videorecording (007/00 = v)
+	videoDesignationCodes = map[string]string{
+		"c": "Videocartridge",
+		"d": "Videodisc",
+		"f": "Videocassette",
+		"r": "Videoreel",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	videoFormatCodes = map[string]string{
+		"a": "Beta (1/2 in., videocassette)",
+		"b": "VHS (1/2 in., videocassette)",
+		"c": "U-matic (3/4 in., videocassette)",
+		"d": "EIAJ (1/2 in., reel)",
+		"e": "Type C (1 in., reel)",
+		"f": "Quadruplex (1 in. or 2 in., reel)",
+		"g": "Laserdisc",
+		"h": "CED (Capacitance Electronic Disc) videodisc",
+		"i": "Betacam (1/2 in., videocassette)",
+		"j": "Betacam SP (1/2 in., videocassette)",
+		"k": "Super-VHS (1/2 in., videocassette)",
+		"m": "M-II (1/2 in., videocassette)",
+		"o": "D-2 (3/4 in., videocassette)",
+		"p": "8 mm.",
+		"q": "Hi-8 mm.",
+		"s": "Blu-ray disc",
+		"u": "Unknown",
+		"v": "DVD",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	videoDimensionsCodes = map[string]string{
+		"a": "8 mm.",
+		"m": "1/4 in.",
+		"o": "1/2 in.",
+		"p": "1 in.",
+		"q": "2 in.",
+		"r": "3/4 in.",
+		"u": "Unknown",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	// Unspecified (007/00 = z)
+	unspecifiedMaterialCodes = map[string]string{
+		"m": "Multiple physical forms",
+		"u": "Unspecified",
+		"z": "Other",
+		"|": noAttempt,
+	}
+)
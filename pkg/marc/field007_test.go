@@ -0,0 +1,88 @@
+package marc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestFields007(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	fields, err := record.Fields007()
+	if err != ErrBadField007Len {
+		t.Errorf("expected %v for an abbreviated 007, got %v", ErrBadField007Len, err)
+	}
+	if len(fields) != 1 {
+		t.Fatalf("expected 1 field, got %d", len(fields))
+	}
+
+	f := fields[0]
+	if f.ElectronicResource == nil {
+		t.Fatalf("expected the electronic resource description")
+	}
+	tests := []struct {
+		name string
+		got  FixedValue
+		want FixedValue
+	}{
+		{name: "category", got: f.Category, want: FixedValue{Code: "c", Label: "Electronic resource"}},
+		{name: "specific material", got: f.SpecificMaterial, want: FixedValue{Code: "r", Label: "Remote"}},
+		{name: "color", got: f.ElectronicResource.Color, want: FixedValue{Code: "c", Label: "Multicolored"}},
+		{name: "dimensions", got: f.ElectronicResource.Dimensions, want: FixedValue{Code: "n", Label: "Not applicable"}},
+		{name: "missing", got: f.ElectronicResource.Compression, want: FixedValue{}},
+	}
+	for _, tt := range tests {
+		if !cmp.Equal(tt.got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, tt.got)
+		}
+	}
+}
+
+func TestNewField007(t *testing.T) {
+	t.Parallel()
+
+	f, err := NewField007("sd fsngnnmmned")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if f.SoundRecording == nil {
+		t.Fatalf("expected the sound recording description")
+	}
+	want := FixedValue{Code: "g", Label: "4 3/4 in. or 12 cm. diameter"}
+	if !cmp.Equal(f.SoundRecording.Dimensions, want) {
+		t.Errorf("expected %v, got %v", want, f.SoundRecording.Dimensions)
+	}
+	want = FixedValue{Code: "e", Label: "Digital recording"}
+	if !cmp.Equal(f.SoundRecording.PlaybackCharacteristics, want) {
+		t.Errorf("expected %v, got %v", want, f.SoundRecording.PlaybackCharacteristics)
+	}
+
+	f, err = NewField007("vd cvaizq")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = FixedValue{Code: "v", Label: "DVD"}
+	if f.Videorecording == nil || !cmp.Equal(f.Videorecording.Format, want) {
+		t.Errorf("expected %v, got %v", want, f.Videorecording)
+	}
+
+	f, err = NewField007("aj canzn")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want = FixedValue{Code: "a", Label: "Paper"}
+	if f.Map == nil || !cmp.Equal(f.Map.PhysicalMedium, want) {
+		t.Errorf("expected %v, got %v", want, f.Map)
+	}
+
+	f, err = NewField007("ta")
+	if err != nil || f.SpecificMaterial.Label != "Regular print" {
+		t.Errorf("unexpected text 007 %v (%v)", f, err)
+	}
+
+	if _, err := NewField007("x"); err != ErrBadField007Category {
+		t.Errorf("expected %v, got %v", ErrBadField007Category, err)
+	}
+}
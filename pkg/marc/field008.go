@@ -0,0 +1,352 @@
+package marc
+
+import "errors"
+
+var (
+	ErrNoField008     = errors.New("record has no 008 field")
+	ErrBadField008Len = errors.New("008 field is not 40 characters long")
+)
+
+// Material configurations of the 008 (and 006) fields
+const (
+	MaterialBooks               = "Books"
+	MaterialComputerFiles       = "Computer files"
+	MaterialMaps                = "Maps"
+	MaterialMusic               = "Music"
+	MaterialContinuingResources = "Continuing resources"
+	MaterialVisualMaterials     = "Visual materials"
+	MaterialMixedMaterials      = "Mixed materials"
+)
+
+// Books are the 008/18-34 (006/01-17) elements for books.
+type Books struct {
+	Illustrations         FixedValue
+	TargetAudience        FixedValue
+	FormOfItem            FixedValue
+	NatureOfContents      FixedValue
+	GovernmentPublication FixedValue
+	ConferencePublication FixedValue
+	Festschrift           FixedValue
+	Index                 FixedValue
+	LiteraryForm          FixedValue
+	Biography             FixedValue
+}
+
+// ComputerFiles are the 008/18-34 (006/01-17) elements for computer files.
+type ComputerFiles struct {
+	TargetAudience        FixedValue
+	FormOfItem            FixedValue
+	TypeOfFile            FixedValue
+	GovernmentPublication FixedValue
+}
+
+// Maps are the 008/18-34 (006/01-17) elements for cartographic materials.
+type Maps struct {
+	Relief                FixedValue
+	Projection            FixedValue
+	TypeOfMaterial        FixedValue
+	GovernmentPublication FixedValue
+	FormOfItem            FixedValue
+	Index                 FixedValue
+	SpecialFormat         FixedValue
+}
+
+// Music are the 008/18-34 (006/01-17) elements for music and sound recordings.
+type Music struct {
+	FormOfComposition  FixedValue
+	FormatOfMusic      FixedValue
+	MusicParts         FixedValue
+	TargetAudience     FixedValue
+	FormOfItem         FixedValue
+	AccompanyingMatter FixedValue
+	LiteraryText       FixedValue
+	Transposition      FixedValue
+}
+
+// ContinuingResources are the 008/18-34 (006/01-17) elements for serials
+// and integrating resources.
+type ContinuingResources struct {
+	Frequency             FixedValue
+	Regularity            FixedValue
+	TypeOfResource        FixedValue
+	FormOfOriginalItem    FixedValue
+	FormOfItem            FixedValue
+	NatureOfEntireWork    FixedValue
+	NatureOfContents      FixedValue
+	GovernmentPublication FixedValue
+	ConferencePublication FixedValue
+	OriginalScript        FixedValue
+	EntryConvention       FixedValue
+}
+
+// VisualMaterials are the 008/18-34 (006/01-17) elements for visual materials.
+type VisualMaterials struct {
+	RunningTime           FixedValue
+	TargetAudience        FixedValue
+	GovernmentPublication FixedValue
+	FormOfItem            FixedValue
+	TypeOfMaterial        FixedValue
+	Technique             FixedValue
+}
+
+// MixedMaterials are the 008/18-34 (006/01-17) elements for mixed materials.
+type MixedMaterials struct {
+	FormOfItem FixedValue
+}
+
+// MaterialElements are the material specific elements of the 008 or 006.
+// Only the pointer for the material configuration in Type is set.
+type MaterialElements struct {
+	Type                string
+	Books               *Books
+	ComputerFiles       *ComputerFiles
+	Maps                *Maps
+	Music               *Music
+	ContinuingResources *ContinuingResources
+	VisualMaterials     *VisualMaterials
+	MixedMaterials      *MixedMaterials
+}
+
+// Field008 represents the decoded 008 (fixed-length data elements)
+// of a bibliographic record.
+// See https://www.loc.gov/marc/bibliographic/bd008.html
+type Field008 struct {
+	DateEntered      FixedValue // 00-05
+	TypeOfDate       FixedValue // 06
+	Date1            FixedValue // 07-10
+	Date2            FixedValue // 11-14
+	Place            FixedValue // 15-17
+	Language         FixedValue // 35-37
+	ModifiedRecord   FixedValue // 38
+	CatalogingSource FixedValue // 39
+	MaterialElements            // 18-34
+	Elements         []FixedElement
+}
+
+var field008Specs = []fixedSpec{
+	{start: 0, end: 6, name: "Date entered on file"},
+	{start: 6, end: 7, name: "Type of date/Publication status", codes: typeOfDateCodes},
+	{start: 7, end: 11, name: "Date 1"},
+	{start: 11, end: 15, name: "Date 2"},
+	{start: 15, end: 18, name: "Place of publication, production, or execution"},
+}
+
+var field008EndSpecs = []fixedSpec{
+	{start: 35, end: 38, name: "Language"},
+	{start: 38, end: 39, name: "Modified record", codes: modifiedRecordCodes},
+	{start: 39, end: 40, name: "Cataloging source", codes: catalogingSourceCodes},
+}
+
+// Positions of the material specific elements are relative to 008/18
+// (006/01) so that they can be used for both fields.
+var materialSpecs = map[string][]fixedSpec{
+	MaterialBooks: {
+		{start: 0, end: 4, name: "Illustrations", codes: illustrationsCodes, multi: true},
+		{start: 4, end: 5, name: "Target audience", codes: targetAudienceCodes},
+		{start: 5, end: 6, name: "Form of item", codes: formOfItemCodes},
+		{start: 6, end: 10, name: "Nature of contents", codes: natureOfContentsCodes, multi: true},
+		{start: 10, end: 11, name: "Government publication", codes: governmentPublicationCodes},
+		{start: 11, end: 12, name: "Conference publication", codes: yesNoCodes},
+		{start: 12, end: 13, name: "Festschrift", codes: yesNoCodes},
+		{start: 13, end: 14, name: "Index", codes: yesNoCodes},
+		{start: 15, end: 16, name: "Literary form", codes: literaryFormCodes},
+		{start: 16, end: 17, name: "Biography", codes: biographyCodes},
+	},
+	MaterialComputerFiles: {
+		{start: 4, end: 5, name: "Target audience", codes: targetAudienceCodes},
+		{start: 5, end: 6, name: "Form of item", codes: computerFormOfItemCodes},
+		{start: 8, end: 9, name: "Type of computer file", codes: typeOfComputerFileCodes},
+		{start: 10, end: 11, name: "Government publication", codes: governmentPublicationCodes},
+	},
+	MaterialMaps: {
+		{start: 0, end: 4, name: "Relief", codes: reliefCodes, multi: true},
+		{start: 4, end: 6, name: "Projection", codes: projectionCodes},
+		{start: 7, end: 8, name: "Type of cartographic material", codes: typeOfCartographicCodes},
+		{start: 10, end: 11, name: "Government publication", codes: governmentPublicationCodes},
+		{start: 11, end: 12, name: "Form of item", codes: formOfItemCodes},
+		{start: 13, end: 14, name: "Index", codes: yesNoCodes},
+		{start: 15, end: 17, name: "Special format characteristics", codes: specialFormatCodes, multi: true},
+	},
+	MaterialMusic: {
+		{start: 0, end: 2, name: "Form of composition", codes: formOfCompositionCodes},
+		{start: 2, end: 3, name: "Format of music", codes: formatOfMusicCodes},
+		{start: 3, end: 4, name: "Music parts", codes: musicPartsCodes},
+		{start: 4, end: 5, name: "Target audience", codes: targetAudienceCodes},
+		{start: 5, end: 6, name: "Form of item", codes: formOfItemCodes},
+		{start: 6, end: 12, name: "Accompanying matter", codes: accompanyingMatterCodes, multi: true},
+		{start: 12, end: 14, name: "Literary text for sound recordings", codes: literaryTextCodes, multi: true},
+		{start: 15, end: 16, name: "Transposition and arrangement", codes: transpositionCodes},
+	},
+	MaterialContinuingResources: {
+		{start: 0, end: 1, name: "Frequency", codes: frequencyCodes},
+		{start: 1, end: 2, name: "Regularity", codes: regularityCodes},
+		{start: 3, end: 4, name: "Type of continuing resource", codes: typeOfContinuingResourceCodes},
+		{start: 4, end: 5, name: "Form of original item", codes: formOfOriginalItemCodes},
+		{start: 5, end: 6, name: "Form of item", codes: formOfItemCodes},
+		{start: 6, end: 7, name: "Nature of entire work", codes: natureOfContentsCodes},
+		{start: 7, end: 10, name: "Nature of contents", codes: natureOfContentsCodes, multi: true},
+		{start: 10, end: 11, name: "Government publication", codes: governmentPublicationCodes},
+		{start: 11, end: 12, name: "Conference publication", codes: yesNoCodes},
+		{start: 15, end: 16, name: "Original alphabet or script of title", codes: originalScriptCodes},
+		{start: 16, end: 17, name: "Entry convention", codes: entryConventionCodes},
+	},
+	MaterialVisualMaterials: {
+		{start: 0, end: 3, name: "Running time", codes: runningTimeCodes, open: true},
+		{start: 4, end: 5, name: "Target audience", codes: targetAudienceCodes},
+		{start: 10, end: 11, name: "Government publication", codes: governmentPublicationCodes},
+		{start: 11, end: 12, name: "Form of item", codes: formOfItemCodes},
+		{start: 15, end: 16, name: "Type of visual material", codes: typeOfVisualMaterialCodes},
+		{start: 16, end: 17, name: "Technique", codes: techniqueCodes},
+	},
+	MaterialMixedMaterials: {
+		{start: 5, end: 6, name: "Form of item", codes: formOfItemCodes},
+	},
+}
+
+var runningTimeCodes = map[string]string{
+	"000": "Running time exceeds three characters",
+	"---": "Unknown",
+	"nnn": "Not applicable",
+	"|||": noAttempt,
+}
+
+// MaterialType returns the material configuration of the 008 for the
+// type of record (leader/06) and bibliographic level (leader/07), or an
+// empty string if there is no configuration for the type of record.
+func (l Leader) MaterialType() string {
+	switch l.Type {
+	case 'a', 't':
+		if l.BibLevel == 'b' || l.BibLevel == 'i' || l.BibLevel == 's' {
+			return MaterialContinuingResources
+		}
+		return MaterialBooks
+	case 'm':
+		return MaterialComputerFiles
+	case 'e', 'f':
+		return MaterialMaps
+	case 'c', 'd', 'i', 'j':
+		return MaterialMusic
+	case 'g', 'k', 'o', 'r':
+		return MaterialVisualMaterials
+	case 'p':
+		return MaterialMixedMaterials
+	}
+	return ""
+}
+
+// NewField008 decodes the value of an 008 field using the material
+// configuration indicated in the leader. Values shorter than 40
+// characters are decoded as much as possible and ErrBadField008Len
+// is returned.
+func NewField008(value string, leader Leader) (Field008, error) {
+	var err error
+	if len(value) != 40 {
+		err = ErrBadField008Len
+	}
+
+	elements := fixedElements{}
+	decodeFixed(value, 0, field008Specs, &elements)
+	material := decodeMaterial(value, 18, leader.MaterialType(), &elements)
+	decodeFixed(value, 0, field008EndSpecs, &elements)
+
+	f := Field008{
+		DateEntered:      elements.get("Date entered on file"),
+		TypeOfDate:       elements.get("Type of date/Publication status"),
+		Date1:            elements.get("Date 1"),
+		Date2:            elements.get("Date 2"),
+		Place:            elements.get("Place of publication, production, or execution"),
+		Language:         elements.get("Language"),
+		ModifiedRecord:   elements.get("Modified record"),
+		CatalogingSource: elements.get("Cataloging source"),
+		MaterialElements: material,
+		Elements:         elements.list,
+	}
+	return f, err
+}
+
+// Field008 returns the decoded 008 field of the record.
+func (r Record) Field008() (Field008, error) {
+	for _, field := range r.FieldsByTag("008") {
+		return NewField008(field.Value, r.Leader)
+	}
+	return Field008{}, ErrNoField008
+}
+
+// decodeMaterial decodes the material specific elements in value
+// starting at the given offset.
+func decodeMaterial(value string, offset int, material string, elements *fixedElements) MaterialElements {
+	decodeFixed(value, offset, materialSpecs[material], elements)
+	get := elements.get
+	m := MaterialElements{Type: material}
+	switch material {
+	case MaterialBooks:
+		m.Books = &Books{
+			Illustrations:         get("Illustrations"),
+			TargetAudience:        get("Target audience"),
+			FormOfItem:            get("Form of item"),
+			NatureOfContents:      get("Nature of contents"),
+			GovernmentPublication: get("Government publication"),
+			ConferencePublication: get("Conference publication"),
+			Festschrift:           get("Festschrift"),
+			Index:                 get("Index"),
+			LiteraryForm:          get("Literary form"),
+			Biography:             get("Biography"),
+		}
+	case MaterialComputerFiles:
+		m.ComputerFiles = &ComputerFiles{
+			TargetAudience:        get("Target audience"),
+			FormOfItem:            get("Form of item"),
+			TypeOfFile:            get("Type of computer file"),
+			GovernmentPublication: get("Government publication"),
+		}
+	case MaterialMaps:
+		m.Maps = &Maps{
+			Relief:                get("Relief"),
+			Projection:            get("Projection"),
+			TypeOfMaterial:        get("Type of cartographic material"),
+			GovernmentPublication: get("Government publication"),
+			FormOfItem:            get("Form of item"),
+			Index:                 get("Index"),
+			SpecialFormat:         get("Special format characteristics"),
+		}
+	case MaterialMusic:
+		m.Music = &Music{
+			FormOfComposition:  get("Form of composition"),
+			FormatOfMusic:      get("Format of music"),
+			MusicParts:         get("Music parts"),
+			TargetAudience:     get("Target audience"),
+			FormOfItem:         get("Form of item"),
+			AccompanyingMatter: get("Accompanying matter"),
+			LiteraryText:       get("Literary text for sound recordings"),
+			Transposition:      get("Transposition and arrangement"),
+		}
+	case MaterialContinuingResources:
+		m.ContinuingResources = &ContinuingResources{
+			Frequency:             get("Frequency"),
+			Regularity:            get("Regularity"),
+			TypeOfResource:        get("Type of continuing resource"),
+			FormOfOriginalItem:    get("Form of original item"),
+			FormOfItem:            get("Form of item"),
+			NatureOfEntireWork:    get("Nature of entire work"),
+			NatureOfContents:      get("Nature of contents"),
+			GovernmentPublication: get("Government publication"),
+			ConferencePublication: get("Conference publication"),
+			OriginalScript:        get("Original alphabet or script of title"),
+			EntryConvention:       get("Entry convention"),
+		}
+	case MaterialVisualMaterials:
+		m.VisualMaterials = &VisualMaterials{
+			RunningTime:           get("Running time"),
+			TargetAudience:        get("Target audience"),
+			GovernmentPublication: get("Government publication"),
+			FormOfItem:            get("Form of item"),
+			TypeOfMaterial:        get("Type of visual material"),
+			Technique:             get("Technique"),
+		}
+	case MaterialMixedMaterials:
+		m.MixedMaterials = &MixedMaterials{
+			FormOfItem: get("Form of item"),
+		}
+	}
+	return m
+}
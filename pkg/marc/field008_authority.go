@@ -0,0 +1,254 @@
+package marc
+
+// AuthorityField008 represents the decoded 008 (fixed-length data
+// elements) of an authority record.
+// See https://www.loc.gov/marc/authority/ad008.html
+type AuthorityField008 struct {
+	DateEntered              FixedValue // 00-05
+	GeographicSubdivision    FixedValue // 06
+	RomanizationScheme       FixedValue // 07
+	LanguageOfCatalog        FixedValue // 08
+	KindOfRecord             FixedValue // 09
+	DescriptiveRules         FixedValue // 10
+	SubjectHeadingSystem     FixedValue // 11
+	TypeOfSeries             FixedValue // 12
+	NumberedSeries           FixedValue // 13
+	MainOrAddedEntryUse      FixedValue // 14
+	SubjectAddedEntryUse     FixedValue // 15
+	SeriesAddedEntryUse      FixedValue // 16
+	TypeOfSubjectSubdivision FixedValue // 17
+	TypeOfGovernmentAgency   FixedValue // 28
+	ReferenceEvaluation      FixedValue // 29
+	RecordUpdateInProcess    FixedValue // 31
+	UndifferentiatedName     FixedValue // 32
+	LevelOfEstablishment     FixedValue // 33
+	ModifiedRecord           FixedValue // 38
+	CatalogingSource         FixedValue // 39
+	Elements                 []FixedElement
+}
+
+var authorityField008Specs = []fixedSpec{
+	{start: 0, end: 6, name: "Date entered on file"},
+	{start: 6, end: 7, name: "Direct or indirect geographic subdivision", codes: authorityGeographicSubdivisionCodes},
+	{start: 7, end: 8, name: "Romanization scheme", codes: authorityRomanizationCodes},
+	{start: 8, end: 9, name: "Language of catalog", codes: authorityLanguageOfCatalogCodes},
+	{start: 9, end: 10, name: "Kind of record", codes: authorityKindOfRecordCodes},
+	{start: 10, end: 11, name: "Descriptive cataloging rules", codes: authorityDescriptiveRulesCodes},
+	{start: 11, end: 12, name: "Subject heading system/thesaurus", codes: authorityThesaurusCodes},
+	{start: 12, end: 13, name: "Type of series", codes: authorityTypeOfSeriesCodes},
+	{start: 13, end: 14, name: "Numbered or unnumbered series", codes: authorityNumberedSeriesCodes},
+	{start: 14, end: 15, name: "Heading use-main or added entry", codes: authorityHeadingUseCodes},
+	{start: 15, end: 16, name: "Heading use-subject added entry", codes: authorityHeadingUseCodes},
+	{start: 16, end: 17, name: "Heading use-series added entry", codes: authorityHeadingUseCodes},
+	{start: 17, end: 18, name: "Type of subject subdivision", codes: authoritySubjectSubdivisionCodes},
+	{start: 28, end: 29, name: "Type of government agency", codes: authorityGovernmentAgencyCodes},
+	{start: 29, end: 30, name: "Reference evaluation", codes: authorityReferenceEvaluationCodes},
+	{start: 31, end: 32, name: "Record update in process", codes: authorityUpdateInProcessCodes},
+	{start: 32, end: 33, name: "Undifferentiated personal name", codes: authorityUndifferentiatedNameCodes},
+	{start: 33, end: 34, name: "Level of establishment", codes: authorityLevelOfEstablishmentCodes},
+	{start: 38, end: 39, name: "Modified record", codes: authorityModifiedRecordCodes},
+	{start: 39, end: 40, name: "Cataloging source", codes: authorityCatalogingSourceCodes},
+}
+
+// NewAuthorityField008 decodes the value of the 008 field of an
+// authority record. Values shorter than 40 characters are decoded as
+// much as possible and ErrBadField008Len is returned.
+func NewAuthorityField008(value string) (AuthorityField008, error) {
+	var err error
+	if len(value) != 40 {
+		err = ErrBadField008Len
+	}
+
+	elements := fixedElements{}
+	decodeFixed(value, 0, authorityField008Specs, &elements)
+	get := elements.get
+	f := AuthorityField008{
+		DateEntered:              get("Date entered on file"),
+		GeographicSubdivision:    get("Direct or indirect geographic subdivision"),
+		RomanizationScheme:       get("Romanization scheme"),
+		LanguageOfCatalog:        get("Language of catalog"),
+		KindOfRecord:             get("Kind of record"),
+		DescriptiveRules:         get("Descriptive cataloging rules"),
+		SubjectHeadingSystem:     get("Subject heading system/thesaurus"),
+		TypeOfSeries:             get("Type of series"),
+		NumberedSeries:           get("Numbered or unnumbered series"),
+		MainOrAddedEntryUse:      get("Heading use-main or added entry"),
+		SubjectAddedEntryUse:     get("Heading use-subject added entry"),
+		SeriesAddedEntryUse:      get("Heading use-series added entry"),
+		TypeOfSubjectSubdivision: get("Type of subject subdivision"),
+		TypeOfGovernmentAgency:   get("Type of government agency"),
+		ReferenceEvaluation:      get("Reference evaluation"),
+		RecordUpdateInProcess:    get("Record update in process"),
+		UndifferentiatedName:     get("Undifferentiated personal name"),
+		LevelOfEstablishment:     get("Level of establishment"),
+		ModifiedRecord:           get("Modified record"),
+		CatalogingSource:         get("Cataloging source"),
+		Elements:                 elements.list,
+	}
+	return f, err
+}
+
+// AuthorityField008 returns the decoded 008 field of an authority record.
+func (r Record) AuthorityField008() (AuthorityField008, error) {
+	for _, field := range r.FieldsByTag("008") {
+		return NewAuthorityField008(field.Value)
+	}
+	return AuthorityField008{}, ErrNoField008
+}
+
+// Code values for the 008 of authority records.
+var (
+	authorityGeographicSubdivisionCodes = map[string]string{
+		" ": "Not subdivided geographically",
+		"d": "Subdivided geographically-direct",
+		"i": "Subdivided geographically-indirect",
+		"n": "Not applicable",
+		"|": noAttempt,
+	}
+
+	authorityRomanizationCodes = map[string]string{
+		"a": "International standard",
+		"b": "National standard",
+		"c": "National library association standard",
+		"d": "National library or bibliographic agency standard",
+		"e": "Local standard",
+		"f": "Standard of unknown origin",
+		"g": "Conventional romanization or conventional form of name in language of cataloging agency",
+		"n": "Not applicable",
+		"|": noAttempt,
+	}
+
+	authorityLanguageOfCatalogCodes = map[string]string{
+		" ": "No information provided",
+		"b": "English and French",
+		"e": "English only",
+		"f": "French only",
+		"|": noAttempt,
+	}
+
+	authorityKindOfRecordCodes = map[string]string{
+		"a": "Established heading",
+		"b": "Untraced reference",
+		"c": "Traced reference",
+		"d": "Subdivision",
+		"e": "Node label",
+		"f": "Established heading and subdivision",
+		"g": "Reference and subdivision",
+	}
+
+	authorityDescriptiveRulesCodes = map[string]string{
+		"a": "Earlier rules",
+		"b": "AACR 1",
+		"c": "AACR 2",
+		"d": "AACR 2 compatible heading",
+		"n": "Not applicable",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	authorityThesaurusCodes = map[string]string{
+		"a": "Library of Congress Subject Headings",
+		"b": "LC subject headings for children's literature",
+		"c": "Medical Subject Headings",
+		"d": "National Agricultural Library subject authority file",
+		"k": "Canadian Subject Headings",
+		"n": "Not applicable",
+		"r": "Art and Architecture Thesaurus",
+		"s": "Sears List of Subject Headings",
+		"v": "Répertoire de vedettes-matière",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	authorityTypeOfSeriesCodes = map[string]string{
+		"a": "Monographic series",
+		"b": "Multipart item",
+		"c": "Series-like phrase",
+		"n": "Not applicable",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	authorityNumberedSeriesCodes = map[string]string{
+		"a": "Numbered",
+		"b": "Unnumbered",
+		"c": "Numbering varies",
+		"n": "Not applicable",
+		"|": noAttempt,
+	}
+
+	authorityHeadingUseCodes = map[string]string{
+		"a": "Appropriate",
+		"b": "Not appropriate",
+		"|": noAttempt,
+	}
+
+	authoritySubjectSubdivisionCodes = map[string]string{
+		"a": "Topical",
+		"b": "Form",
+		"c": "Chronological",
+		"d": "Geographic",
+		"e": "Language",
+		"n": "Not applicable",
+		"|": noAttempt,
+	}
+
+	authorityGovernmentAgencyCodes = map[string]string{
+		" ": "Not a government agency",
+		"a": "Autonomous or semi-autonomous component",
+		"c": "Multilocal",
+		"f": "Federal/national",
+		"i": "International intergovernmental",
+		"l": "Local",
+		"m": "Multistate",
+		"o": "Government agency-type undetermined",
+		"s": "State, provincial, territorial, dependent, etc.",
+		"u": "Unknown if heading is government agency",
+		"z": "Other",
+		"|": noAttempt,
+	}
+
+	authorityReferenceEvaluationCodes = map[string]string{
+		"a": "Tracings are consistent with the heading",
+		"b": "Tracings are not necessarily consistent with the heading",
+		"n": "Not applicable",
+		"|": noAttempt,
+	}
+
+	authorityUpdateInProcessCodes = map[string]string{
+		"a": "Record can be used",
+		"b": "Record is being updated",
+		"|": noAttempt,
+	}
+
+	authorityUndifferentiatedNameCodes = map[string]string{
+		"a": "Differentiated personal name",
+		"b": "Undifferentiated personal name",
+		"n": "Not applicable",
+		"|": noAttempt,
+	}
+
+	authorityLevelOfEstablishmentCodes = map[string]string{
+		"a": "Fully established",
+		"b": "Memorandum",
+		"c": "Provisional",
+		"d": "Preliminary",
+		"n": "Not applicable",
+		"|": noAttempt,
+	}
+
+	authorityModifiedRecordCodes = map[string]string{
+		" ": "Not modified",
+		"s": "Shortened",
+		"x": "Missing characters",
+		"|": noAttempt,
+	}
+
+	authorityCatalogingSourceCodes = map[string]string{
+		" ": "National bibliographic agency",
+		"c": "Cooperative cataloging program",
+		"d": "Other",
+		"u": "Unknown",
+		"|": noAttempt,
+	}
+)
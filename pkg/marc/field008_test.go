@@ -0,0 +1,102 @@
+package marc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestField008(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	f, err := record.Field008()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		got  FixedValue
+		want FixedValue
+	}{
+		{name: "type of date", got: f.TypeOfDate, want: FixedValue{Code: "s", Label: "Single known date/probable date"}},
+		{name: "date 1", got: f.Date1, want: FixedValue{Code: "1976"}},
+		{name: "date 2", got: f.Date2, want: FixedValue{Code: "    "}},
+		{name: "place", got: f.Place, want: FixedValue{Code: "dcu"}},
+		{name: "language", got: f.Language, want: FixedValue{Code: "eng"}},
+		{name: "cataloging source", got: f.CatalogingSource, want: FixedValue{Code: "c", Label: "Cooperative cataloging program"}},
+		{name: "illustrations", got: f.Books.Illustrations, want: FixedValue{Code: "a   ", Label: "Illustrations"}},
+		{name: "form of item", got: f.Books.FormOfItem, want: FixedValue{Code: "s", Label: "Electronic"}},
+		{name: "nature of contents", got: f.Books.NatureOfContents, want: FixedValue{Code: "b   ", Label: "Bibliographies"}},
+		{name: "government publication", got: f.Books.GovernmentPublication, want: FixedValue{Code: "f", Label: "Federal/national"}},
+		{name: "literary form", got: f.Books.LiteraryForm, want: FixedValue{Code: "0", Label: "Not fiction (not further specified)"}},
+	}
+	for _, tt := range tests {
+		if !cmp.Equal(tt.got, tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, tt.got)
+		}
+	}
+
+	if f.Type != MaterialBooks || f.Maps != nil {
+		t.Errorf("expected only the books configuration, got %v", f.MaterialElements)
+	}
+	if len(f.Elements) != 18 {
+		t.Errorf("expected 18 elements, got %d", len(f.Elements))
+	}
+	if f.Elements[2].String() != "07-10 Date 1: 1976" {
+		t.Errorf("unexpected element %q", f.Elements[2].String())
+	}
+}
+
+func TestNewField008(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		leader   string
+		value    string
+		material string
+	}{
+		{name: "serial", leader: "01234nas a2200385 a 4500", value: "850101c19859999nyumr p       0   a0eng d", material: MaterialContinuingResources},
+		{name: "music", leader: "01234njm a2200385 a 4500", value: "850101s1985    nyurcnn           n eng d", material: MaterialMusic},
+		{name: "map", leader: "01234nem a2200385 a 4500", value: "850101s1985    nyua   bd a     1   eng d", material: MaterialMaps},
+		{name: "video", leader: "01234ngm a2200385 a 4500", value: "850101s1985    nyu120            vleng d", material: MaterialVisualMaterials},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leader, _ := NewLeader([]byte(tt.leader))
+			f, err := NewField008(tt.value, leader)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if f.Type != tt.material {
+				t.Errorf("expected %q, got %q", tt.material, f.Type)
+			}
+		})
+	}
+
+	leader, _ := NewLeader([]byte("01234nas a2200385 a 4500"))
+	f, _ := NewField008("850101c19859999nyumr p       0   a0eng d", leader)
+	if f.ContinuingResources.Frequency.Label != "Monthly" || f.ContinuingResources.Regularity.Label != "Regular" {
+		t.Errorf("unexpected frequency/regularity %v", f.ContinuingResources)
+	}
+
+	leader, _ = NewLeader([]byte("01234nem a2200385 a 4500"))
+	f, _ = NewField008("850101s1985    nyua   bd a     1   eng d", leader)
+	if f.Maps.Projection.Label != "Mercator" || f.Maps.Index.Label != "Yes" {
+		t.Errorf("unexpected maps %v", f.Maps)
+	}
+
+	leader, _ = NewLeader([]byte("01234ngm a2200385 a 4500"))
+	f, _ = NewField008("850101s1985    nyu120            vleng d", leader)
+	if f.VisualMaterials.RunningTime.Code != "120" || f.VisualMaterials.TypeOfMaterial.Label != "Videorecording" {
+		t.Errorf("unexpected visual materials %v", f.VisualMaterials)
+	}
+
+	_, err := NewField008("850101s1985", leader)
+	if err != ErrBadField008Len {
+		t.Errorf("expected %q, got %q", ErrBadField008Len, err)
+	}
+}
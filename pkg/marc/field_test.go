@@ -326,3 +326,50 @@ func compareFields(want, got Field, t *testing.T) {
 		t.Error(cmp.Diff(want, got))
 	}
 }
+
+func TestSubFieldMutations(t *testing.T) {
+	t.Parallel()
+
+	field := Field{Tag: "856", Indicator1: "4", Indicator2: "0", SubFields: []SubField{
+		{Code: "u", Value: "http://example.org"},
+		{Code: "x", Value: "staff note"},
+		{Code: "x", Value: "another note"},
+	}}
+
+	if n := field.RemoveSubFields("x"); n != 2 {
+		t.Errorf("expected 2 subfields removed, got %d", n)
+	}
+	if err := field.SetSubField("u", "https://example.org"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := field.SetSubField("z", "View online"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := field.AddSubField("zz", "bad"); err != ErrInvalidSubfieldCode {
+		t.Errorf("expected %q, got %q", ErrInvalidSubfieldCode, err)
+	}
+	for _, value := range []string{"bad\x1dvalue", "bad\x1evalue", "bad\x1fvalue"} {
+		if err := field.AddSubField("z", value); err != ErrInvalidFieldValue {
+			t.Errorf("%q: expected %q, got %q", value, ErrInvalidFieldValue, err)
+		}
+		if err := field.SetSubField("u", value); err != ErrInvalidFieldValue {
+			t.Errorf("%q: expected %q, got %q", value, ErrInvalidFieldValue, err)
+		}
+	}
+	if err := field.SetIndicators("4", "1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := field.SetIndicators("41", ""); err != ErrInvalidIndicators {
+		t.Errorf("expected %q, got %q", ErrInvalidIndicators, err)
+	}
+
+	want := "=856  41$uhttps://example.org$zView online"
+	if field.String() != want {
+		t.Errorf("expected %q, got %q", want, field.String())
+	}
+
+	control := Field{Tag: "001", Value: "123"}
+	if err := control.AddSubField("a", "x"); err != ErrControlFieldData {
+		t.Errorf("expected %q, got %q", ErrControlFieldData, err)
+	}
+}
@@ -3,6 +3,7 @@ package marc
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -10,52 +11,247 @@ type FieldFilters struct {
 	Fields []FieldFilter
 }
 
+// FieldFilter selects fields of a record, and optionally some of their
+// subfields or character positions, see NewFieldFilter.
 type FieldFilter struct {
-	Tag       string
+	Tag       string // may include wildcards, e.g. 6XX or 1..
+	Ind1      string // "" for any indicator
+	Ind2      string // "" for any indicator
 	Subfields string
+	Start     int // first character position (control fields only)
+	End       int // last character position plus one, 0 when not indicated
 }
 
-var ErrInvalidFieldString = errors.New("invalid field string (too short)")
+var (
+	ErrInvalidFieldString = errors.New("invalid field string (too short)")
+	ErrInvalidFieldTag    = errors.New("invalid field tag (must be three digits or wildcards X or .)")
+	ErrInvalidPositions   = errors.New("invalid positions (must be NN or NN-NN and only in the leader or control fields)")
+	ErrInvalidSubfields   = errors.New("invalid subfields (must be letters or digits and not in the leader or control fields)")
+)
 
 // fieldsStr is a comma delimited string in the format NNNabc,NNNabc
 // where NNN represents the MARC field to output and abc...z represents
 // a set of subfields to include. If no subfields are indicated all
-// subfields for the field are assummed.
+// subfields for the field are assummed. See NewFieldFilter for the
+// other values supported.
 // Example:
 //
 //	"700a" represents MARC field 700, subfield a.
 //	"700ag" represents MARC field 700, subfields a and g.
 //	"700" represents field 700 and all its subfields.
+//	"6XX" represents all the 6XX fields.
 func NewFieldFilters(fieldsStr string) FieldFilters {
-	if fieldsStr == "" {
+	filters, err := ParseFieldFilters(fieldsStr)
+	if err != nil {
 		return FieldFilters{}
 	}
+	return filters
+}
+
+// ParseFieldFilters is like NewFieldFilters but it returns an error
+// if any of the fields in the string is not valid.
+func ParseFieldFilters(fieldsStr string) (FieldFilters, error) {
+	if fieldsStr == "" {
+		return FieldFilters{}, nil
+	}
 	filters := FieldFilters{}
 	for _, value := range strings.Split(fieldsStr, ",") {
-		filter, err := NewFieldFilter(value)
+		filter, err := NewFieldFilter(strings.TrimSpace(value))
 		if err != nil {
-			// TODO: handle error
-			return FieldFilters{}
+			return FieldFilters{}, fmt.Errorf("%s: %w", value, err)
 		}
 		filters.Fields = append(filters.Fields, filter)
 	}
-	return filters
+	return filters, nil
 }
 
-// fieldStr is a string in the format NNNabc
+// NewFieldFilter parses a field string in the format NNN[ind1ind2][abc]
+// or NNN/start[-end]. The tag can include wildcards (X or .) to match any
+// digit, e.g. 6XX or 1.. and two characters (digits, _ for any indicator,
+// or # for blank) after the tag are the indicators when at least one of
+// them is _ or #, e.g. 650_0 for the 650 fields with second indicator 0.
+// Otherwise digits after the tag are subfields, e.g. 65002 for subfields
+// 0 and 2. Use @ before the indicators when both are digits, e.g. 245@10a
+// for subfield a of the 245 fields with indicators 1 and 0. Positions (zero based) select part of the leader or a control
+// field, e.g. 008/35-37.
 func NewFieldFilter(fieldStr string) (FieldFilter, error) {
 	if len(fieldStr) < 3 {
 		return FieldFilter{}, ErrInvalidFieldString
 	}
-	tag := fieldStr[:3]
-	subfields := ""
-	if len(fieldStr) > 3 {
-		subfields = fieldStr[3:]
+	filter := FieldFilter{Tag: fieldStr[:3]}
+	if !isValidFilterTag(filter.Tag) {
+		return FieldFilter{}, ErrInvalidFieldTag
 	}
-	filter := FieldFilter{Tag: tag, Subfields: subfields}
+	rest := fieldStr[3:]
+	isControl := filter.Tag == "LDR" || strings.HasPrefix(filter.Tag, "00")
+
+	if strings.HasPrefix(rest, "/") {
+		if !isControl {
+			return FieldFilter{}, ErrInvalidPositions
+		}
+		positions := strings.SplitN(rest[1:], "-", 2)
+		start, err1 := strconv.Atoi(positions[0])
+		end, err2 := start, error(nil)
+		if len(positions) == 2 {
+			end, err2 = strconv.Atoi(positions[1])
+		}
+		if err1 != nil || err2 != nil || start < 0 || end < start {
+			return FieldFilter{}, ErrInvalidPositions
+		}
+		filter.Start = start
+		filter.End = end + 1
+		return filter, nil
+	}
+
+	if strings.HasPrefix(rest, "@") {
+		if isControl || len(rest) < 3 || !isIndicatorFilter(rest[1]) || !isIndicatorFilter(rest[2]) {
+			return FieldFilter{}, ErrInvalidIndicators
+		}
+		filter.Ind1 = indicatorFilter(rest[1])
+		filter.Ind2 = indicatorFilter(rest[2])
+		rest = rest[3:]
+	} else if len(rest) >= 2 && isIndicatorFilter(rest[0]) && isIndicatorFilter(rest[1]) && !areDigits(rest[0], rest[1]) {
+		filter.Ind1 = indicatorFilter(rest[0])
+		filter.Ind2 = indicatorFilter(rest[1])
+		rest = rest[2:]
+	}
+	for i := 0; i < len(rest); i++ {
+		if !isValidSubfieldCode(string(rest[i])) {
+			return FieldFilter{}, ErrInvalidSubfields
+		}
+	}
+	if isControl && (rest != "" || filter.Ind1 != "" || filter.Ind2 != "") {
+		return FieldFilter{}, ErrInvalidSubfields
+	}
+	filter.Subfields = rest
 	return filter, nil
 }
 
+func isValidFilterTag(tag string) bool {
+	if tag == "LDR" {
+		return true
+	}
+	for i := 0; i < len(tag); i++ {
+		c := tag[i]
+		if !(c >= '0' && c <= '9') && c != 'X' && c != 'x' && c != '.' {
+			return false
+		}
+	}
+	return true
+}
+
+func isIndicatorFilter(c byte) bool {
+	return c == '_' || c == '#' || (c >= '0' && c <= '9')
+}
+
+// areDigits returns true when both characters are digits, which
+// are read as subfield codes rather than indicators (e.g. 03568 for
+// subfields 6 and 8) to keep the meaning of filters without indicators.
+func areDigits(c1, c2 byte) bool {
+	return c1 >= '0' && c1 <= '9' && c2 >= '0' && c2 <= '9'
+}
+
+func indicatorFilter(c byte) string {
+	switch c {
+	case '_':
+		return ""
+	case '#':
+		return " "
+	}
+	return string(c)
+}
+
+// MatchTag returns true if the tag matches the tag of the filter,
+// including wildcards.
+func (filter FieldFilter) MatchTag(tag string) bool {
+	if len(tag) != len(filter.Tag) {
+		return false
+	}
+	for i := 0; i < len(tag); i++ {
+		c := filter.Tag[i]
+		if c == 'X' || c == 'x' || c == '.' {
+			if tag[i] < '0' || tag[i] > '9' {
+				return false
+			}
+		} else if c != tag[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// MatchField returns true if the tag and the indicators of the field
+// match the filter.
+func (filter FieldFilter) MatchField(field Field) bool {
+	if !filter.MatchTag(field.Tag) {
+		return false
+	}
+	if filter.Ind1 != "" && field.Indicator1 != filter.Ind1 {
+		return false
+	}
+	if filter.Ind2 != "" && field.Indicator2 != filter.Ind2 {
+		return false
+	}
+	return true
+}
+
+// HasPositions returns true if the filter selects character positions.
+func (filter FieldFilter) HasPositions() bool {
+	return filter.End > 0
+}
+
+// positions returns the characters of the value at the positions of the
+// filter, false if the value is too short.
+func (filter FieldFilter) positions(value string) (string, bool) {
+	if !filter.HasPositions() {
+		return value, true
+	}
+	if filter.End > len(value) {
+		return "", false
+	}
+	return value[filter.Start:filter.End], true
+}
+
+// withoutPositions returns the value without the characters at the
+// positions of the filters.
+func withoutPositions(value string, filters []FieldFilter) string {
+	removed := make([]bool, len(value))
+	for _, filter := range filters {
+		for i := filter.Start; i < filter.End && i < len(value); i++ {
+			removed[i] = true
+		}
+	}
+	kept := []byte{}
+	for i := 0; i < len(value); i++ {
+		if !removed[i] {
+			kept = append(kept, value[i])
+		}
+	}
+	return string(kept)
+}
+
+// apply returns the field with only the subfields or character positions
+// indicated in the filter, false if nothing in the field was selected.
+func (filter FieldFilter) apply(field Field) (Field, bool) {
+	if field.IsControlField() {
+		value, ok := filter.positions(field.Value)
+		return Field{Tag: field.Tag, Value: value}, ok
+	}
+	if filter.Subfields == "" {
+		// add the value as-is, no need to filter by subfield
+		return field, true
+	}
+	// extract the indicated subfields from the field
+	filteredField := Field{
+		Tag:        field.Tag,
+		Value:      field.Value,
+		Indicator1: field.Indicator1,
+		Indicator2: field.Indicator2,
+		SubFields:  field.GetSubFields(filter.Subfields),
+	}
+	return filteredField, len(filteredField.SubFields) > 0
+}
+
 func (filters FieldFilters) String() string {
 	s := "Filters {\n"
 	for _, field := range filters.Fields {
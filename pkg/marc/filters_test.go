@@ -59,6 +59,21 @@ func TestNewFieldFilter(t *testing.T) {
 		{name: "field string without subfield", fieldStr: "700", filter: FieldFilter{Tag: "700"}, err: nil},
 		{name: "field string with one subfield", fieldStr: "700h", filter: FieldFilter{Tag: "700", Subfields: "h"}, err: nil},
 		{name: "field string with multiple subfields", fieldStr: "245ahc", filter: FieldFilter{Tag: "245", Subfields: "ahc"}, err: nil},
+		{name: "tag wildcard", fieldStr: "6XX", filter: FieldFilter{Tag: "6XX"}, err: nil},
+		{name: "tag wildcard with dots", fieldStr: "1..a", filter: FieldFilter{Tag: "1..", Subfields: "a"}, err: nil},
+		{name: "second indicator", fieldStr: "650_0", filter: FieldFilter{Tag: "650", Ind2: "0"}, err: nil},
+		{name: "blank indicator and subfields", fieldStr: "650#0ax", filter: FieldFilter{Tag: "650", Ind1: " ", Ind2: "0", Subfields: "ax"}, err: nil},
+		{name: "digit subfield without indicators", fieldStr: "6502", filter: FieldFilter{Tag: "650", Subfields: "2"}, err: nil},
+		{name: "digit subfields without indicators", fieldStr: "650__02", filter: FieldFilter{Tag: "650", Subfields: "02"}, err: nil},
+		{name: "two digit subfields are not indicators", fieldStr: "65002", filter: FieldFilter{Tag: "650", Subfields: "02"}, err: nil},
+		{name: "digit subfields 6 and 8", fieldStr: "03568", filter: FieldFilter{Tag: "035", Subfields: "68"}, err: nil},
+		{name: "digit and letter subfields", fieldStr: "24510a", filter: FieldFilter{Tag: "245", Subfields: "10a"}, err: nil},
+		{name: "digit indicator with any", fieldStr: "2451_a", filter: FieldFilter{Tag: "245", Ind1: "1", Subfields: "a"}, err: nil},
+		{name: "digit indicators", fieldStr: "245@10", filter: FieldFilter{Tag: "245", Ind1: "1", Ind2: "0"}, err: nil},
+		{name: "digit indicators and subfields", fieldStr: "600@10ad", filter: FieldFilter{Tag: "600", Ind1: "1", Ind2: "0", Subfields: "ad"}, err: nil},
+		{name: "any indicator after @", fieldStr: "650@_0", filter: FieldFilter{Tag: "650", Ind2: "0"}, err: nil},
+		{name: "control field positions", fieldStr: "008/35-37", filter: FieldFilter{Tag: "008", Start: 35, End: 38}, err: nil},
+		{name: "leader position", fieldStr: "LDR/06", filter: FieldFilter{Tag: "LDR", Start: 6, End: 7}, err: nil},
 	}
 
 	for _, tt := range newFieldFilterTests {
@@ -130,3 +145,64 @@ func TestFieldFiltersString(t *testing.T) {
 		t.Errorf("expected %q, got %q", want, got)
 	}
 }
+
+func TestNewFieldFilterErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		fieldStr string
+		err      error
+	}{
+		{fieldStr: "6YY", err: ErrInvalidFieldTag},
+		{fieldStr: "245/01", err: ErrInvalidPositions},
+		{fieldStr: "008/37-35", err: ErrInvalidPositions},
+		{fieldStr: "008/a", err: ErrInvalidPositions},
+		{fieldStr: "008a", err: ErrInvalidSubfields},
+		{fieldStr: "245$a", err: ErrInvalidSubfields},
+		{fieldStr: "245@1", err: ErrInvalidIndicators},
+		{fieldStr: "245@1a", err: ErrInvalidIndicators},
+		{fieldStr: "008@10", err: ErrInvalidIndicators},
+	}
+
+	for _, tt := range tests {
+		if _, err := NewFieldFilter(tt.fieldStr); err != tt.err {
+			t.Errorf("%s: expected %v, got %v", tt.fieldStr, tt.err, err)
+		}
+	}
+
+	if _, err := ParseFieldFilters("245,6YY"); !errors.Is(err, ErrInvalidFieldTag) {
+		t.Errorf("expected %v, got %v", ErrInvalidFieldTag, err)
+	}
+}
+
+func TestFieldFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	field := Field{Tag: "650", Indicator1: " ", Indicator2: "0"}
+	tests := []struct {
+		fieldStr string
+		want     bool
+	}{
+		{fieldStr: "650", want: true},
+		{fieldStr: "6XX", want: true},
+		{fieldStr: "6..", want: true},
+		{fieldStr: "65x", want: true},
+		{fieldStr: "7XX", want: false},
+		{fieldStr: "650_0", want: true},
+		{fieldStr: "650#_", want: true},
+		{fieldStr: "650_7", want: false},
+		{fieldStr: "6501_", want: false},
+		{fieldStr: "650@#0", want: true},
+		{fieldStr: "650@10", want: false},
+	}
+
+	for _, tt := range tests {
+		filter, err := NewFieldFilter(tt.fieldStr)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.fieldStr, err)
+		}
+		if got := filter.MatchField(field); got != tt.want {
+			t.Errorf("%s: expected %t, got %t", tt.fieldStr, tt.want, got)
+		}
+	}
+}
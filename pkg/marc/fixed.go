@@ -0,0 +1,119 @@
+package marc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FixedValue is the value of a position (or range of positions) in a
+// fixed-length field like the 006, 007, or 008. Label is the human
+// readable meaning of the code and is empty for values that are not
+// coded (e.g. dates) or that come from external code lists (e.g.
+// country or language codes).
+type FixedValue struct {
+	Code  string
+	Label string
+}
+
+// FixedElement is a FixedValue along with its position and name within
+// the field. It is used to display annotated views of the fixed fields.
+type FixedElement struct {
+	Position string // e.g. "07-10"
+	Name     string // e.g. "Date 1"
+	FixedValue
+}
+
+func (e FixedElement) String() string {
+	if e.Label == "" {
+		return fmt.Sprintf("%s %s: %s", e.Position, e.Name, e.Code)
+	}
+	return fmt.Sprintf("%s %s: %s (%s)", e.Position, e.Name, e.Code, e.Label)
+}
+
+// fixedSpec describes one element of a fixed field. Start and end are
+// zero based and end is exclusive. When multi is true each character
+// in the element is a separate code (e.g. the 008 illustrations). When
+// open is true values not in codes are valid (e.g. a running time).
+type fixedSpec struct {
+	start int
+	end   int
+	name  string
+	codes map[string]string
+	multi bool
+	open  bool
+}
+
+// fixedElements holds the decoded elements of a fixed field.
+type fixedElements struct {
+	list   []FixedElement
+	byName map[string]FixedValue
+}
+
+func (e fixedElements) get(name string) FixedValue {
+	return e.byName[name]
+}
+
+// decodeFixed decodes the elements in the specs from value. Offset is
+// added to the positions of the specs, this allows to reuse the same
+// specs for the material specific elements of the 006 and 008.
+func decodeFixed(value string, offset int, specs []fixedSpec, elements *fixedElements) {
+	if elements.byName == nil {
+		elements.byName = map[string]FixedValue{}
+	}
+	for _, spec := range specs {
+		start, end := spec.start+offset, spec.end+offset
+		code := ""
+		if end <= len(value) {
+			code = value[start:end]
+		}
+		fv := FixedValue{Code: code, Label: fixedLabel(spec, code)}
+		position := fmt.Sprintf("%02d", start)
+		if end-start > 1 {
+			position = fmt.Sprintf("%02d-%02d", start, end-1)
+		}
+		elements.list = append(elements.list, FixedElement{Position: position, Name: spec.name, FixedValue: fv})
+		elements.byName[spec.name] = fv
+	}
+}
+
+func fixedLabel(spec fixedSpec, code string) string {
+	if spec.codes == nil || code == "" {
+		return ""
+	}
+	if label, ok := spec.codes[code]; ok {
+		return label
+	}
+	if spec.open {
+		return ""
+	}
+	if !spec.multi {
+		if strings.Trim(code, "|") == "" {
+			return "No attempt to code"
+		}
+		return "Invalid value"
+	}
+
+	labels := []string{}
+	for _, c := range code {
+		if c == ' ' && len(labels) > 0 {
+			continue
+		}
+		label, ok := spec.codes[string(c)]
+		if !ok {
+			label = "Invalid value"
+		}
+		if !containsString(labels, label) {
+			labels = append(labels, label)
+		}
+	}
+	return strings.Join(labels, "; ")
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
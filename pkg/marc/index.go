@@ -0,0 +1,225 @@
+package marc
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// indexHeader is the first value in the header line of an index file.
+const indexHeader = "marcli-index"
+
+var (
+	ErrIndexNotSupported = errors.New("index is only supported for uncompressed MARC binary files")
+	ErrBadIndex          = errors.New("invalid index file")
+	ErrNoIndex           = errors.New("file has no index")
+	ErrRecordNotFound    = errors.New("record not found in the index")
+)
+
+// IndexEntry is the location of a record in a MARC binary file.
+type IndexEntry struct {
+	Offset int64  // byte offset of the record in the file
+	Length int    // length of the record in bytes, including the record terminator
+	Key    string // e.g. the 001 of the record
+}
+
+// Index holds the location of every record in a MARC binary file so
+// that records can be read without parsing the records before them.
+type Index struct {
+	KeyTag  string // control field used as key, e.g. "001"
+	Size    int64  // size of the file in bytes when the index was built
+	ModTime int64  // modification time of the file (Unix nanoseconds) when the index was built
+	Entries []IndexEntry
+	keys    map[string]int
+}
+
+// IndexFilename returns the name of the sidecar file where the index
+// for a MARC file is stored.
+func IndexFilename(filename string) string {
+	return filename + ".idx"
+}
+
+// BuildIndex builds the index for the MARC binary data in the reader.
+// When keyTag is not empty the value of that field (e.g. "001" or "035a",
+// see NewFieldFilter) is stored as the key of each record so records can
+// be found by it.
+func BuildIndex(r io.Reader, keyTag string) (Index, error) {
+	index := Index{KeyTag: keyTag}
+	var key FieldFilter
+	if keyTag != "" {
+		var err error
+		if key, err = NewFieldFilter(keyTag); err != nil {
+			return index, err
+		}
+	}
+
+	reader := bufio.NewReader(r)
+	magic, _ := reader.Peek(5)
+	if string(magic) == "<?xml" || bytes.HasPrefix(magic, []byte{0x1f, 0x8b}) || bytes.HasPrefix(magic, []byte("BZh")) {
+		return index, ErrIndexNotSupported
+	}
+
+	for {
+		data, err := reader.ReadBytes(rt)
+		if len(data) > 0 {
+			entry := IndexEntry{Offset: index.Size, Length: len(data)}
+			if keyTag != "" {
+				rec := Record{}
+				if makeRecordFromBytes(bytes.TrimSuffix(data, []byte{rt}), false, &rec) == nil {
+					entry.Key = recordKey(rec, key)
+				}
+			}
+			index.Entries = append(index.Entries, entry)
+			index.Size += int64(len(data))
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return index, err
+		}
+	}
+	index.buildKeys()
+	return index, nil
+}
+
+// IsCurrent returns true if the index matches the size and modification
+// time of the file, i.e. the file has not changed since the index was
+// built. Edits that keep the size of the file are detected too.
+func (index Index) IsCurrent(info os.FileInfo) bool {
+	return index.Size == info.Size() && index.ModTime == info.ModTime().UnixNano()
+}
+
+// recordKey returns the first value selected by the key in the record.
+func recordKey(r Record, key FieldFilter) string {
+	values := selectedValues(key, r)
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(values[0])
+}
+
+// readIndexedRecord reads the record in the given entry of the index.
+func readIndexedRecord(r io.ReadSeeker, entry IndexEntry, rec *Record) error {
+	if _, err := r.Seek(entry.Offset, io.SeekStart); err != nil {
+		return err
+	}
+	data := make([]byte, entry.Length)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return err
+	}
+	return makeRecordFromBytes(bytes.TrimSuffix(data, []byte{rt}), false, rec)
+}
+
+// Find returns the position (zero based) of the record with the given
+// key. If more than one record has the same key the first one is returned.
+func (index Index) Find(key string) (int, bool) {
+	i, ok := index.keys[key]
+	return i, ok
+}
+
+func (index *Index) buildKeys() {
+	index.keys = map[string]int{}
+	for i, entry := range index.Entries {
+		if _, ok := index.keys[entry.Key]; !ok && entry.Key != "" {
+			index.keys[entry.Key] = i
+		}
+	}
+}
+
+// Write saves the index as text: a header line with the key tag, the
+// size, and the modification time of the MARC file followed by one line
+// per record with its offset, length, and key (tab delimited).
+func (index Index) Write(w io.Writer) error {
+	writer := bufio.NewWriter(w)
+	fmt.Fprintf(writer, "%s\t%s\t%d\t%d\n", indexHeader, index.KeyTag, index.Size, index.ModTime)
+	for _, entry := range index.Entries {
+		fmt.Fprintf(writer, "%d\t%d\t%s\n", entry.Offset, entry.Length, entry.Key)
+	}
+	return writer.Flush()
+}
+
+// ReadIndex reads an index saved with Write.
+func ReadIndex(r io.Reader) (Index, error) {
+	index := Index{}
+	scanner := bufio.NewScanner(r)
+	if !scanner.Scan() {
+		return index, ErrBadIndex
+	}
+	header := strings.Split(scanner.Text(), "\t")
+	if len(header) != 4 || header[0] != indexHeader {
+		return index, ErrBadIndex
+	}
+	size, err1 := strconv.ParseInt(header[2], 10, 64)
+	modTime, err2 := strconv.ParseInt(header[3], 10, 64)
+	if err1 != nil || err2 != nil {
+		return index, ErrBadIndex
+	}
+	index.KeyTag = header[1]
+	index.Size = size
+	index.ModTime = modTime
+
+	for scanner.Scan() {
+		values := strings.SplitN(scanner.Text(), "\t", 3)
+		if len(values) != 3 {
+			return index, ErrBadIndex
+		}
+		offset, err1 := strconv.ParseInt(values[0], 10, 64)
+		length, err2 := strconv.Atoi(values[1])
+		if err1 != nil || err2 != nil {
+			return index, ErrBadIndex
+		}
+		index.Entries = append(index.Entries, IndexEntry{Offset: offset, Length: length, Key: values[2]})
+	}
+	if err := scanner.Err(); err != nil {
+		return index, err
+	}
+	index.buildKeys()
+	return index, nil
+}
+
+// NewIndexedMarcFile creates a struct to read the records of an
+// uncompressed MARC binary file with the given index, which allows
+// to seek to a record with SeekRecord and SeekKey. Reading starts at
+// the beginning of the file.
+func NewIndexedMarcFile(r io.ReadSeeker, index Index) MarcFile {
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return MarcFile{err: err}
+	}
+	return MarcFile{scanner: newBinaryScanner(r), seeker: r, index: index}
+}
+
+// SeekRecord positions the file so that the next call to Scan reads
+// the record at the given position (zero based).
+func (file *MarcFile) SeekRecord(i int) error {
+	if file.seeker == nil {
+		return ErrNoIndex
+	}
+	if i < 0 || i >= len(file.index.Entries) {
+		return ErrRecordNotFound
+	}
+	_, err := file.seeker.Seek(file.index.Entries[i].Offset, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	file.scanner = newBinaryScanner(file.seeker)
+	return nil
+}
+
+// SeekKey positions the file so that the next call to Scan reads the
+// record with the given key (e.g. the 001 of the record).
+func (file *MarcFile) SeekKey(key string) error {
+	if file.seeker == nil {
+		return ErrNoIndex
+	}
+	i, ok := file.index.Find(key)
+	if !ok {
+		return ErrRecordNotFound
+	}
+	return file.SeekRecord(i)
+}
@@ -0,0 +1,150 @@
+package marc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestBuildIndex(t *testing.T) {
+	t.Parallel()
+
+	file, err := os.Open("testdata/test_10.mrc")
+	if err != nil {
+		t.Fatalf("problem opening test data file: %v", err)
+	}
+	defer file.Close()
+
+	index, err := BuildIndex(file, "001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info, _ := file.Stat()
+	if len(index.Entries) != 10 || index.Size != info.Size() {
+		t.Fatalf("expected 10 entries and size %d, got %d and %d", info.Size(), len(index.Entries), index.Size)
+	}
+	if index.Entries[0].Offset != 0 || index.Entries[1].Offset != int64(index.Entries[0].Length) {
+		t.Errorf("unexpected offsets %v", index.Entries[:2])
+	}
+	if i, ok := index.Find("ocm57178089"); !ok || i != 5 {
+		t.Errorf("expected record 5, got %d (%t)", i, ok)
+	}
+
+	index.ModTime = info.ModTime().UnixNano()
+	if !index.IsCurrent(info) {
+		t.Errorf("expected index to be current")
+	}
+
+	// Round trip to the sidecar format
+	var buf bytes.Buffer
+	if err := index.Write(&buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	read, err := ReadIndex(&buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(read.Entries) != 10 || read.Entries[9] != index.Entries[9] || read.KeyTag != "001" || read.ModTime != index.ModTime {
+		t.Errorf("unexpected index read %v", read)
+	}
+	if i, ok := read.Find("ocm57178089"); !ok || i != 5 {
+		t.Errorf("expected record 5, got %d (%t)", i, ok)
+	}
+
+	if _, err := ReadIndex(bytes.NewBufferString("bad")); err != ErrBadIndex {
+		t.Errorf("expected %v, got %v", ErrBadIndex, err)
+	}
+
+	gz, _ := os.Open("testdata/test_10.mrc.gz")
+	defer gz.Close()
+	if _, err := BuildIndex(gz, "001"); err != ErrIndexNotSupported {
+		t.Errorf("expected %v, got %v", ErrIndexNotSupported, err)
+	}
+}
+
+func TestIndexedMarcFile(t *testing.T) {
+	t.Parallel()
+
+	file, err := os.Open("testdata/test_10.mrc")
+	if err != nil {
+		t.Fatalf("problem opening test data file: %v", err)
+	}
+	defer file.Close()
+	index, _ := BuildIndex(file, "001")
+
+	marc := NewIndexedMarcFile(file, index)
+	if err := marc.SeekRecord(8); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ids := []string{}
+	for marc.Scan() {
+		r, err := marc.Record()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, r.ControlNum())
+	}
+	if len(ids) != 2 || ids[0] != index.Entries[8].Key || ids[1] != index.Entries[9].Key {
+		t.Errorf("unexpected records %v", ids)
+	}
+
+	if err := marc.SeekKey("ocm57178089"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	marc.Scan()
+	if r, _ := marc.Record(); r.ControlNum() != "ocm57178089" {
+		t.Errorf("unexpected record %q", r.ControlNum())
+	}
+
+	if err := marc.SeekKey("missing"); err != ErrRecordNotFound {
+		t.Errorf("expected %v, got %v", ErrRecordNotFound, err)
+	}
+	if err := marc.SeekRecord(10); err != ErrRecordNotFound {
+		t.Errorf("expected %v, got %v", ErrRecordNotFound, err)
+	}
+
+	notIndexed := NewMarcFile(bytes.NewReader(nil))
+	if err := notIndexed.SeekRecord(0); err != ErrNoIndex {
+		t.Errorf("expected %v, got %v", ErrNoIndex, err)
+	}
+}
+
+func TestIndexIsCurrent(t *testing.T) {
+	t.Parallel()
+
+	data, err := ioutil.ReadFile("testdata/test_10.mrc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	file, err := ioutil.TempFile("", "marcli-index-test")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer os.Remove(file.Name())
+	file.Write(data)
+	file.Close()
+
+	index, err := BuildIndex(bytes.NewReader(data), "001")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	info, _ := os.Stat(file.Name())
+	index.ModTime = info.ModTime().UnixNano()
+	if !index.IsCurrent(info) {
+		t.Errorf("expected index to be current")
+	}
+
+	// An edit that keeps the size of the file
+	data[100] = 'X'
+	if err := ioutil.WriteFile(file.Name(), data, 0644); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	later := info.ModTime().Add(time.Second)
+	os.Chtimes(file.Name(), later, later)
+	info, _ = os.Stat(file.Name())
+	if info.Size() != index.Size || index.IsCurrent(info) {
+		t.Errorf("expected index to be out of date")
+	}
+}
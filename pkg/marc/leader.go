@@ -14,6 +14,7 @@ type Leader struct {
 	Type          byte // 06
 	BibLevel      byte // 07
 	Control       byte // 08
+	CharCoding    byte // 09, blank for MARC-8, "a" for UCS/Unicode
 	EncodingLevel byte // 17
 	Form          byte // 18
 	Multipart     byte // 19
@@ -41,6 +42,7 @@ func NewLeader(bytes []byte) (Leader, error) {
 		Type:          bytes[6],
 		BibLevel:      bytes[7],
 		Control:       bytes[8],
+		CharCoding:    bytes[9],
 		EncodingLevel: bytes[17],
 		Form:          bytes[18],
 		Multipart:     bytes[19],
@@ -48,6 +50,263 @@ func NewLeader(bytes []byte) (Leader, error) {
 	return leader, err
 }
 
+// LeaderError represents an invalid value in a position of the leader.
+type LeaderError struct {
+	Position int
+	Value    byte
+	Details  string
+}
+
+func (e *LeaderError) Error() string {
+	return fmt.Sprintf("leader/%02d: invalid value %q (%s)", e.Position, e.Value, e.Details)
+}
+
+// RecordLength returns the length of the record (positions 00-04)
+// or -1 if the value is not numeric.
+func (l Leader) RecordLength() int {
+	return l.number(0, 5)
+}
+
+// IndicatorCount returns the number of indicators (position 10)
+// or -1 if the value is not numeric.
+func (l Leader) IndicatorCount() int {
+	return l.number(10, 11)
+}
+
+// SubfieldCodeLength returns the length of the subfield code
+// (position 11) or -1 if the value is not numeric.
+func (l Leader) SubfieldCodeLength() int {
+	return l.number(11, 12)
+}
+
+// BaseAddress returns the base address of data (positions 12-16)
+// or -1 if the value is not numeric.
+func (l Leader) BaseAddress() int {
+	return l.dataOffset
+}
+
+// LengthOfFieldLength returns the number of characters used for the
+// length of the field in the directory (position 20, entry map).
+func (l Leader) LengthOfFieldLength() int {
+	return l.number(20, 21)
+}
+
+// LengthOfStartingPosition returns the number of characters used for
+// the starting character position in the directory (position 21).
+func (l Leader) LengthOfStartingPosition() int {
+	return l.number(21, 22)
+}
+
+// LengthOfImplementation returns the number of characters used for the
+// implementation-defined portion of the directory (position 22).
+func (l Leader) LengthOfImplementation() int {
+	return l.number(22, 23)
+}
+
+// Undefined returns the undefined entry map position (position 23).
+func (l Leader) Undefined() int {
+	return l.number(23, 24)
+}
+
+func (l Leader) number(start, end int) int {
+	if len(l.raw) != leaderLength {
+		return -1
+	}
+	value, err := strconv.Atoi(string(l.raw[start:end]))
+	if err != nil {
+		return -1
+	}
+	return value
+}
+
+// IsAuthority returns true for authority records (leader/06 = z).
+func (l Leader) IsAuthority() bool {
+	return l.Type == 'z'
+}
+
+// IsHoldings returns true for holdings records (leader/06 = u, v, x, or y).
+func (l Leader) IsHoldings() bool {
+	return l.Type == 'u' || l.Type == 'v' || l.Type == 'x' || l.Type == 'y'
+}
+
+// StatusDescription returns a human readable description of leader/05.
+func (l Leader) StatusDescription() string {
+	return describe(leaderStatusCodes, l.Status)
+}
+
+// TypeDescription returns a human readable description of leader/06.
+func (l Leader) TypeDescription() string {
+	return describe(leaderTypeCodes, l.Type)
+}
+
+// BibLevelDescription returns a human readable description of leader/07.
+func (l Leader) BibLevelDescription() string {
+	return describe(leaderBibLevelCodes, l.BibLevel)
+}
+
+// ControlDescription returns a human readable description of leader/08.
+func (l Leader) ControlDescription() string {
+	return describe(leaderControlCodes, l.Control)
+}
+
+// CharCodingDescription returns a human readable description of leader/09.
+func (l Leader) CharCodingDescription() string {
+	return describe(leaderCharCodingCodes, l.CharCoding)
+}
+
+// EncodingLevelDescription returns a human readable description of
+// leader/17. The values depend on the type of record.
+func (l Leader) EncodingLevelDescription() string {
+	return describe(l.encodingLevelCodes(), l.EncodingLevel)
+}
+
+// FormDescription returns a human readable description of leader/18.
+func (l Leader) FormDescription() string {
+	return describe(leaderFormCodes, l.Form)
+}
+
+// MultipartDescription returns a human readable description of leader/19.
+func (l Leader) MultipartDescription() string {
+	return describe(leaderMultipartCodes, l.Multipart)
+}
+
+func (l Leader) encodingLevelCodes() map[byte]string {
+	if l.IsAuthority() {
+		return leaderAuthorityEncodingLevelCodes
+	}
+	if l.IsHoldings() {
+		return leaderHoldingsEncodingLevelCodes
+	}
+	return leaderEncodingLevelCodes
+}
+
+func describe(codes map[byte]string, value byte) string {
+	if description, ok := codes[value]; ok {
+		return description
+	}
+	return fmt.Sprintf("Invalid value (%q)", value)
+}
+
+// Validate returns the errors found in the leader, e.g. non-numeric
+// values in the record length or invalid codes in the coded positions.
+// Positions 18 and 19 are only validated for bibliographic records.
+func (l Leader) Validate() []error {
+	errs := []error{}
+	if len(l.raw) != leaderLength {
+		return append(errs, errors.New("incomplete leader"))
+	}
+
+	numeric := func(start, end int, details string) {
+		if l.number(start, end) == -1 {
+			errs = append(errs, &LeaderError{Position: start, Value: l.raw[start], Details: details})
+		}
+	}
+	fixed := func(pos int, value byte, details string) {
+		if l.raw[pos] != value {
+			errs = append(errs, &LeaderError{Position: pos, Value: l.raw[pos], Details: details})
+		}
+	}
+	coded := func(pos int, codes map[byte]string, details string) {
+		if _, ok := codes[l.raw[pos]]; !ok {
+			errs = append(errs, &LeaderError{Position: pos, Value: l.raw[pos], Details: details})
+		}
+	}
+
+	numeric(0, 5, "record length must be numeric")
+	coded(5, leaderStatusCodes, "record status")
+	coded(6, leaderTypeCodes, "type of record")
+	if !l.IsAuthority() && !l.IsHoldings() {
+		coded(7, leaderBibLevelCodes, "bibliographic level")
+		coded(8, leaderControlCodes, "type of control")
+	}
+	coded(9, leaderCharCodingCodes, "character coding scheme")
+	fixed(10, '2', "indicator count must be 2")
+	fixed(11, '2', "subfield code length must be 2")
+	numeric(offsetStart, offsetEnd, "base address of data must be numeric")
+	coded(17, l.encodingLevelCodes(), "encoding level")
+	if !l.IsAuthority() && !l.IsHoldings() {
+		coded(18, leaderFormCodes, "descriptive cataloging form")
+		coded(19, leaderMultipartCodes, "multipart resource record level")
+	}
+	fixed(20, '4', "length of the length-of-field portion must be 4")
+	fixed(21, '5', "length of the starting-character-position portion must be 5")
+	fixed(22, '0', "length of the implementation-defined portion must be 0")
+	fixed(23, '0', "undefined entry map position must be 0")
+	return errs
+}
+
+// SetStatus sets the record status (leader/05).
+func (l *Leader) SetStatus(value byte) error {
+	return l.setCoded(5, value, leaderStatusCodes, "record status")
+}
+
+// SetType sets the type of record (leader/06).
+func (l *Leader) SetType(value byte) error {
+	return l.setCoded(6, value, leaderTypeCodes, "type of record")
+}
+
+// SetBibLevel sets the bibliographic level (leader/07).
+func (l *Leader) SetBibLevel(value byte) error {
+	return l.setCoded(7, value, leaderBibLevelCodes, "bibliographic level")
+}
+
+// SetControl sets the type of control (leader/08).
+func (l *Leader) SetControl(value byte) error {
+	return l.setCoded(8, value, leaderControlCodes, "type of control")
+}
+
+// SetCharCoding sets the character coding scheme (leader/09). Notice
+// that this does not convert the data in the record.
+func (l *Leader) SetCharCoding(value byte) error {
+	return l.setCoded(9, value, leaderCharCodingCodes, "character coding scheme")
+}
+
+// SetEncodingLevel sets the encoding level (leader/17).
+func (l *Leader) SetEncodingLevel(value byte) error {
+	return l.setCoded(17, value, l.encodingLevelCodes(), "encoding level")
+}
+
+// SetForm sets the descriptive cataloging form (leader/18).
+func (l *Leader) SetForm(value byte) error {
+	return l.setCoded(18, value, leaderFormCodes, "descriptive cataloging form")
+}
+
+// SetMultipart sets the multipart resource record level (leader/19).
+func (l *Leader) SetMultipart(value byte) error {
+	return l.setCoded(19, value, leaderMultipartCodes, "multipart resource record level")
+}
+
+// SetRecordLength sets the record length (leader/00-04).
+func (l *Leader) SetRecordLength(length int) error {
+	return l.setNumber(0, 5, length)
+}
+
+// SetBaseAddress sets the base address of data (leader/12-16).
+func (l *Leader) SetBaseAddress(address int) error {
+	return l.setNumber(offsetStart, offsetEnd, address)
+}
+
+func (l *Leader) setCoded(pos int, value byte, codes map[byte]string, details string) error {
+	if _, ok := codes[value]; !ok {
+		return &LeaderError{Position: pos, Value: value, Details: details}
+	}
+	return l.Set(pos, value)
+}
+
+func (l *Leader) setNumber(start, end int, value int) error {
+	str := fmt.Sprintf("%0*d", end-start, value)
+	if value < 0 || len(str) != end-start {
+		return &LeaderError{Position: start, Value: str[0], Details: "value out of range"}
+	}
+	if len(l.raw) != leaderLength {
+		*l, _ = NewLeader([]byte(defaultLeader))
+	}
+	raw := append([]byte(nil), l.raw...)
+	copy(raw[start:end], str)
+	*l, _ = NewLeader(raw)
+	return nil
+}
+
 func (l Leader) String() string {
 	return fmt.Sprintf("=LDR  %s", string(l.raw))
 }
@@ -55,3 +314,41 @@ func (l Leader) String() string {
 func (l Leader) Raw() string {
 	return string(l.raw)
 }
+
+// IsMarc8 returns true if the leader indicates that the record
+// is encoded in MARC-8 (blank in position 09).
+func (l Leader) IsMarc8() bool {
+	return l.CharCoding == ' '
+}
+
+// ErrLeaderPosition is returned when trying to set a leader position
+// that is calculated when the record is serialized (record length,
+// base address of data, indicator and subfield code counts, and entry
+// map) or that is out of range.
+var ErrLeaderPosition = errors.New("leader position cannot be set")
+
+// Set changes the value of the byte at the given (zero based) position
+// of the leader, e.g. Set(5, 'd') to mark the record as deleted.
+func (l *Leader) Set(pos int, value byte) error {
+	if pos < 5 || pos >= leaderLength || pos == 10 || pos == 11 ||
+		(pos >= offsetStart && pos < offsetEnd) || pos >= 20 {
+		return ErrLeaderPosition
+	}
+	if len(l.raw) != leaderLength {
+		*l, _ = NewLeader([]byte(defaultLeader))
+	}
+	l.set(pos, value)
+	return nil
+}
+
+// set changes the value of the byte at the given position and
+// updates the rest of the leader accordingly.
+func (l *Leader) set(pos int, value byte) {
+	if len(l.raw) != leaderLength {
+		return
+	}
+	raw := append([]byte(nil), l.raw...)
+	raw[pos] = value
+	leader, _ := NewLeader(raw)
+	*l = leader
+}
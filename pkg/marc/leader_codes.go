@@ -0,0 +1,111 @@
+package marc
+
+// Code values for the leader positions of MARC 21 records.
+// See https://www.loc.gov/marc/bibliographic/bdleader.html
+var (
+	leaderStatusCodes = map[byte]string{
+		'a': "Increase in encoding level",
+		'c': "Corrected or revised",
+		'd': "Deleted",
+		'n': "New",
+		'p': "Increase in encoding level from prepublication",
+		's': "Deleted; heading split into two or more headings",
+		'x': "Deleted; heading replaced by another heading",
+	}
+
+	leaderTypeCodes = map[byte]string{
+		'a': "Language material",
+		'c': "Notated music",
+		'd': "Manuscript notated music",
+		'e': "Cartographic material",
+		'f': "Manuscript cartographic material",
+		'g': "Projected medium",
+		'i': "Nonmusical sound recording",
+		'j': "Musical sound recording",
+		'k': "Two-dimensional nonprojectable graphic",
+		'm': "Computer file",
+		'o': "Kit",
+		'p': "Mixed materials",
+		'q': "Community information",
+		'r': "Three-dimensional artifact or naturally occurring object",
+		't': "Manuscript language material",
+		'u': "Unknown (holdings)",
+		'v': "Multipart item holdings",
+		'w': "Classification data",
+		'x': "Single-part item holdings",
+		'y': "Serial item holdings",
+		'z': "Authority data",
+	}
+
+	leaderBibLevelCodes = map[byte]string{
+		' ': "Not applicable",
+		'a': "Monographic component part",
+		'b': "Serial component part",
+		'c': "Collection",
+		'd': "Subunit",
+		'i': "Integrating resource",
+		'm': "Monograph/Item",
+		's': "Serial",
+	}
+
+	leaderControlCodes = map[byte]string{
+		' ': "No specified type",
+		'a': "Archival",
+	}
+
+	leaderCharCodingCodes = map[byte]string{
+		' ': "MARC-8",
+		'a': "UCS/Unicode",
+	}
+
+	leaderEncodingLevelCodes = map[byte]string{
+		' ': "Full level",
+		'1': "Full level, material not examined",
+		'2': "Less-than-full level, material not examined",
+		'3': "Abbreviated level",
+		'4': "Core level",
+		'5': "Partial (preliminary) level",
+		'7': "Minimal level",
+		'8': "Prepublication level",
+		'u': "Unknown",
+		'z': "Not applicable",
+		// OCLC specific values
+		'I': "Full level input by OCLC participants",
+		'J': "Deleted record (OCLC)",
+		'K': "Less-than-full level input by OCLC participants",
+		'L': "Full level input added from a batch process (OCLC)",
+		'M': "Less-than-full level added from a batch process (OCLC)",
+	}
+
+	leaderAuthorityEncodingLevelCodes = map[byte]string{
+		'n': "Complete authority record",
+		'o': "Incomplete authority record",
+	}
+
+	leaderHoldingsEncodingLevelCodes = map[byte]string{
+		'1': "Holdings level 1",
+		'2': "Holdings level 2",
+		'3': "Holdings level 3",
+		'4': "Holdings level 4",
+		'5': "Holdings level 4 with piece designation",
+		'm': "Mixed level",
+		'u': "Unknown",
+		'z': "Other level",
+	}
+
+	leaderFormCodes = map[byte]string{
+		' ': "Non-ISBD",
+		'a': "AACR 2",
+		'c': "ISBD punctuation omitted",
+		'i': "ISBD punctuation included",
+		'n': "Non-ISBD punctuation omitted",
+		'u': "Unknown",
+	}
+
+	leaderMultipartCodes = map[byte]string{
+		' ': "Not specified or not applicable",
+		'a': "Set",
+		'b': "Part with independent title",
+		'c': "Part with dependent title",
+	}
+)
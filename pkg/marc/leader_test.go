@@ -18,6 +18,7 @@ func TestNewLeader(t *testing.T) {
 		Type:          byte('a'),
 		BibLevel:      byte('m'),
 		Control:       byte(' '),
+		CharCoding:    byte('a'),
 		EncodingLevel: byte(' '),
 		Form:          byte('i'),
 		Multipart:     byte(' '),
@@ -79,3 +80,125 @@ func TestRaw(t *testing.T) {
 		t.Errorf("expected %q, got %q", want, got)
 	}
 }
+
+func TestLeaderSet(t *testing.T) {
+	t.Parallel()
+
+	l, _ := NewLeader([]byte("01848nam a2200385 i 4500"))
+	if err := l.Set(5, 'd'); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if l.Status != 'd' || l.Raw() != "01848dam a2200385 i 4500" {
+		t.Errorf("unexpected leader %q", l.Raw())
+	}
+
+	for _, pos := range []int{0, 4, 10, 11, 12, 16, 20, 23, 24, -1} {
+		if err := l.Set(pos, '0'); err != ErrLeaderPosition {
+			t.Errorf("expected %q for position %d, got %q", ErrLeaderPosition, pos, err)
+		}
+	}
+}
+
+func TestLeaderAccessors(t *testing.T) {
+	t.Parallel()
+
+	l, _ := NewLeader([]byte("01848nam a2200385 i 4500"))
+
+	numbers := []struct {
+		name string
+		got  int
+		want int
+	}{
+		{name: "record length", got: l.RecordLength(), want: 1848},
+		{name: "indicator count", got: l.IndicatorCount(), want: 2},
+		{name: "subfield code length", got: l.SubfieldCodeLength(), want: 2},
+		{name: "base address", got: l.BaseAddress(), want: 385},
+		{name: "length of field length", got: l.LengthOfFieldLength(), want: 4},
+		{name: "length of starting position", got: l.LengthOfStartingPosition(), want: 5},
+		{name: "length of implementation", got: l.LengthOfImplementation(), want: 0},
+		{name: "undefined", got: l.Undefined(), want: 0},
+	}
+	for _, tt := range numbers {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %d, got %d", tt.name, tt.want, tt.got)
+		}
+	}
+
+	descriptions := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{name: "status", got: l.StatusDescription(), want: "New"},
+		{name: "type", got: l.TypeDescription(), want: "Language material"},
+		{name: "bib level", got: l.BibLevelDescription(), want: "Monograph/Item"},
+		{name: "control", got: l.ControlDescription(), want: "No specified type"},
+		{name: "char coding", got: l.CharCodingDescription(), want: "UCS/Unicode"},
+		{name: "encoding level", got: l.EncodingLevelDescription(), want: "Full level"},
+		{name: "form", got: l.FormDescription(), want: "ISBD punctuation included"},
+		{name: "multipart", got: l.MultipartDescription(), want: "Not specified or not applicable"},
+	}
+	for _, tt := range descriptions {
+		if tt.got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.name, tt.want, tt.got)
+		}
+	}
+}
+
+func TestLeaderValidate(t *testing.T) {
+	t.Parallel()
+
+	l, _ := NewLeader([]byte("01848nam a2200385 i 4500"))
+	if errs := l.Validate(); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+
+	l, _ = NewLeader([]byte("0184Xn#m a3200385 Q 4501"))
+	errs := l.Validate()
+	positions := []int{}
+	for _, err := range errs {
+		positions = append(positions, err.(*LeaderError).Position)
+	}
+	want := []int{0, 6, 10, 18, 23}
+	if !cmp.Equal(positions, want) {
+		t.Errorf("expected errors in positions %v, got %v", want, positions)
+	}
+
+	// Authority records use different encoding levels
+	l, _ = NewLeader([]byte("00538nz  a2200169n  4500"))
+	if errs := l.Validate(); len(errs) != 0 {
+		t.Errorf("expected no errors, got %v", errs)
+	}
+}
+
+func TestLeaderSetters(t *testing.T) {
+	t.Parallel()
+
+	l, _ := NewLeader([]byte("01848nam a2200385 i 4500"))
+	if err := l.SetType('j'); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := l.SetEncodingLevel('7'); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := l.SetRecordLength(512); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := l.SetBaseAddress(97); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "00512njm a22000977i 4500"
+	if l.Raw() != want {
+		t.Errorf("expected %q, got %q", want, l.Raw())
+	}
+	if l.Type != 'j' || l.BaseAddress() != 97 {
+		t.Errorf("fields not updated: %v", l)
+	}
+
+	if err := l.SetBibLevel('Q'); err == nil {
+		t.Error("want error for invalid bibliographic level")
+	}
+	if err := l.SetRecordLength(100000); err == nil {
+		t.Error("want error for record length out of range")
+	}
+}
@@ -0,0 +1,182 @@
+package marc
+
+import "strings"
+
+// Linkage represents the value of subfield $6 (linkage) that links a
+// field with its alternate graphic representation in an 880 field.
+// For example "880-01/$1" in a 245 field or "245-01/$1" in the 880.
+// See https://www.loc.gov/marc/bibliographic/ecbdcntf.html
+type Linkage struct {
+	Tag         string // linking tag, e.g. "880" or "245"
+	Occurrence  string // occurrence number, "00" for unlinked 880 fields
+	Script      string // script identification code, e.g. "$1" for CJK
+	RightToLeft bool   // orientation of the field is right-to-left
+}
+
+// Linkage returns the parsed value of subfield $6 of the field. Returns
+// false if the field has no valid $6.
+func (f Field) Linkage() (Linkage, bool) {
+	for _, sub := range f.SubFields {
+		if sub.Code != "6" {
+			continue
+		}
+		return parseLinkage(sub.Value)
+	}
+	return Linkage{}, false
+}
+
+func parseLinkage(value string) (Linkage, bool) {
+	values := strings.Split(value, "/")
+	tagOccurrence := strings.SplitN(values[0], "-", 2)
+	if len(tagOccurrence) != 2 || len(tagOccurrence[0]) != 3 {
+		return Linkage{}, false
+	}
+
+	l := Linkage{Tag: tagOccurrence[0], Occurrence: tagOccurrence[1]}
+	for _, value := range values[1:] {
+		if value == "r" {
+			l.RightToLeft = true
+		} else if l.Script == "" {
+			l.Script = value
+		}
+	}
+	return l, true
+}
+
+// isLinkedTo returns true if field is the field linked to other
+// through $6, e.g. an 880 with "245-01" and a 245 with "880-01".
+func (f Field) isLinkedTo(other Field) bool {
+	l1, ok1 := f.Linkage()
+	l2, ok2 := other.Linkage()
+	if !ok1 || !ok2 || l1.Occurrence == "00" {
+		return false
+	}
+	return l1.Tag == other.Tag && l2.Tag == f.Tag && l1.Occurrence == l2.Occurrence
+}
+
+// LinkedField returns the field linked to the given field through $6:
+// the 880 for a regular field or the regular field for an 880. Returns
+// false if the field is not linked.
+func (r Record) LinkedField(field Field) (Field, bool) {
+	for _, other := range r.Fields {
+		if other.isLinkedTo(field) {
+			return other, true
+		}
+	}
+	return Field{}, false
+}
+
+// VernacularFields returns the 880 fields that are an alternate graphic
+// representation of the given tag, including the unlinked ones
+// (occurrence number 00).
+func (r Record) VernacularFields(tag string) []Field {
+	var fields []Field
+	for _, field := range r.FieldsByTag("880") {
+		if l, ok := field.Linkage(); ok && l.Tag == tag {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// GetVernacularValue returns the first value in the 880 fields for a
+// field tag/subfield combination, e.g. the vernacular 245 $a.
+func (r Record) GetVernacularValue(tag string, subfield string) string {
+	for _, value := range r.GetVernacularValues(tag, subfield) {
+		return value
+	}
+	return ""
+}
+
+// GetVernacularValues returns the values in the 880 fields for a field
+// tag/subfield combination.
+func (r Record) GetVernacularValues(tag string, subfield string) []string {
+	values := []string{}
+	for _, field := range r.VernacularFields(tag) {
+		for _, sub := range field.SubFields {
+			if sub.Code == subfield {
+				values = append(values, sub.Value)
+			}
+		}
+	}
+	return values
+}
+
+// PairLinkedFields returns the fields with each linked 880 right after
+// the field it is linked to, which is convenient to display the
+// vernacular form next to the romanized one. Linked 880 fields are
+// taken from the record so they are included even if they are not in
+// fields.
+func (r Record) PairLinkedFields(fields []Field) []Field {
+	paired := []Field{}
+	for _, field := range fields {
+		if field.Tag == "880" {
+			if linked, ok := r.LinkedField(field); ok && containsField(fields, linked) {
+				// added next to the field it is linked to
+				continue
+			}
+			paired = append(paired, field)
+			continue
+		}
+		paired = append(paired, field)
+		if linked, ok := r.LinkedField(field); ok {
+			paired = append(paired, linked)
+		}
+	}
+	return paired
+}
+
+func containsField(fields []Field, field Field) bool {
+	for _, f := range fields {
+		if f.Tag == field.Tag && f.String() == field.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// PairFilteredFields is like PairLinkedFields for the fields selected by
+// the filters (see Filter). The pairing is done on all the fields of the
+// record and the filters are applied to the pairs: an 880 is selected
+// with the filters for the field it is linked to (e.g. 245a selects
+// subfield a of the 880 linked to the 245) or for its own tag, and 880
+// in the exclude filters removes it.
+func (r Record) PairFilteredFields(include FieldFilters, exclude FieldFilters) []Field {
+	paired := []Field{}
+	for _, field := range r.Fields {
+		if field.Tag == "880" {
+			if _, ok := r.LinkedField(field); ok {
+				// added next to the field it is linked to
+				continue
+			}
+		}
+		paired = append(paired, filterAs(field, field.Tag, include, exclude)...)
+
+		linked, ok := r.LinkedField(field)
+		if !ok || field.Tag == "880" {
+			continue
+		}
+		own := filterAs(linked, "880", FieldFilters{}, exclude)
+		if len(own) == 0 {
+			continue
+		}
+		selected := filterAs(own[0], field.Tag, include, exclude)
+		if len(selected) == 0 && len(include.Fields) > 0 {
+			selected = filterAs(own[0], "880", include, exclude)
+		}
+		paired = append(paired, selected...)
+	}
+	return paired
+}
+
+// filterAs applies the filters to the field as if it had the given tag.
+// The fields returned keep their original tag.
+func filterAs(field Field, tag string, include FieldFilters, exclude FieldFilters) []Field {
+	original := field.Tag
+	field.Tag = tag
+	fields := Record{Fields: []Field{field}}.Filter(include, exclude)
+	for i := range fields {
+		fields[i].Tag = original
+	}
+	return fields
+}
@@ -0,0 +1,111 @@
+package marc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseLinkage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value string
+		want  Linkage
+		ok    bool
+	}{
+		{value: "880-01", want: Linkage{Tag: "880", Occurrence: "01"}, ok: true},
+		{value: "245-02/$1", want: Linkage{Tag: "245", Occurrence: "02", Script: "$1"}, ok: true},
+		{value: "100-01/(3/r", want: Linkage{Tag: "100", Occurrence: "01", Script: "(3", RightToLeft: true}, ok: true},
+		{value: "500-00/r", want: Linkage{Tag: "500", Occurrence: "00", RightToLeft: true}, ok: true},
+		{value: "bad", want: Linkage{}, ok: false},
+	}
+
+	for _, tt := range tests {
+		got, ok := parseLinkage(tt.value)
+		if ok != tt.ok || !cmp.Equal(got, tt.want) {
+			t.Errorf("%q: expected %v (%t), got %v (%t)", tt.value, tt.want, tt.ok, got, ok)
+		}
+	}
+}
+
+func TestLinkedField(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_880.mrc", t)
+
+	title := record.FieldsByTag("245")[0]
+	vern, ok := record.LinkedField(title)
+	if !ok || vern.Tag != "880" || vern.SubFields[1].Value != "こころ /" {
+		t.Errorf("unexpected linked field %v (%t)", vern, ok)
+	}
+
+	back, ok := record.LinkedField(vern)
+	if !ok || back.String() != title.String() {
+		t.Errorf("expected %v, got %v (%t)", title, back, ok)
+	}
+
+	unlinked := record.FieldsByTag("880")[3]
+	if _, ok := record.LinkedField(unlinked); ok {
+		t.Errorf("expected unlinked 880 to have no linked field")
+	}
+	if _, ok := record.LinkedField(record.FieldsByTag("650")[0]); ok {
+		t.Errorf("expected 650 to have no linked field")
+	}
+}
+
+func TestGetVernacularValues(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_880.mrc", t)
+	if got := record.GetVernacularValue("245", "a"); got != "こころ /" {
+		t.Errorf("unexpected vernacular title %q", got)
+	}
+	if got := record.GetVernacularValues("500", "a"); !cmp.Equal(got, []string{"岩波文庫."}) {
+		t.Errorf("unexpected vernacular notes %v", got)
+	}
+	if got := record.GetVernacularValues("650", "a"); len(got) != 0 {
+		t.Errorf("expected no vernacular subjects, got %v", got)
+	}
+}
+
+func TestPairLinkedFields(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_880.mrc", t)
+	tags := func(fields []Field) []string {
+		values := []string{}
+		for _, field := range fields {
+			values = append(values, field.Tag)
+		}
+		return values
+	}
+
+	got := tags(record.PairLinkedFields(record.Fields))
+	want := []string{"001", "008", "100", "880", "245", "880", "260", "880", "650", "880"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	got = tags(record.PairLinkedFields(record.FieldsByTag("245")))
+	want = []string{"245", "880"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	include := NewFieldFilters("245a")
+	paired := record.PairFilteredFields(include, FieldFilters{})
+	if len(paired) != 2 || paired[1].Tag != "880" {
+		t.Fatalf("expected 245 and 880, got %v", tags(paired))
+	}
+	if got := paired[1].String(); got != "=880  10$aこころ /" {
+		t.Errorf("unexpected vernacular title %q", got)
+	}
+
+	exclude := NewFieldFilters("880")
+	got = tags(record.PairFilteredFields(FieldFilters{}, exclude))
+	want = []string{"001", "008", "100", "245", "260", "650"}
+	if !cmp.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
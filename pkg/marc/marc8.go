@@ -0,0 +1,466 @@
+package marc
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+const esc = 0x1b
+
+// ErrUnmappedMarc8 is returned when a record encoded in MARC-8 has
+// characters that cannot be converted to UTF-8. The record is left in
+// MARC-8 rather than losing those characters.
+var ErrUnmappedMarc8 = errors.New("record has MARC-8 characters that cannot be converted to UTF-8")
+
+// marc8Decoder keeps track of the character sets designated as G0
+// and G1 while decoding MARC-8 data. By default G0 is ASCII and G1
+// is ANSEL.
+type marc8Decoder struct {
+	g0 byte
+	g1 byte
+}
+
+// DecodeMarc8 converts MARC-8 encoded data into a UTF-8 string.
+//
+// Combining diacritics, which in MARC-8 precede the letter they modify,
+// are moved after the letter as required by Unicode. Escape sequences
+// to switch to other character sets (Greek, Cyrillic, Hebrew, Arabic,
+// CJK, subscripts, superscripts) are honored until the end of the data.
+// Numeric character references (e.g. &#x4E2D;), used by the MARC 21
+// lossless conversion guidelines for characters not available in MARC-8,
+// are converted to the character they represent. Characters that cannot
+// be mapped are replaced with U+FFFD, notice that this includes the Han
+// ideographs and Hangul of the CJK set (EACC), only its kana are mapped,
+// and the Extended Arabic set.
+func DecodeMarc8(data []byte) string {
+	s, _ := decodeMarc8(data)
+	return s
+}
+
+// decodeMarc8 converts MARC-8 encoded data into a UTF-8 string and
+// reports whether all the characters could be mapped.
+func decodeMarc8(data []byte) (string, bool) {
+	d := marc8Decoder{g0: charsetBasicLatin, g1: charsetExtendedLatin}
+	mapped := true
+	var sb strings.Builder
+	var pending []rune // combining characters waiting for their base
+	flush := func(r rune) {
+		sb.WriteRune(r)
+		for _, c := range pending {
+			sb.WriteRune(c)
+		}
+		pending = nil
+	}
+
+	for i := 0; i < len(data); {
+		b := data[i]
+		if b == esc {
+			i += d.escape(data[i:])
+			continue
+		}
+
+		if b < 0x20 || b == 0x7f {
+			// control characters (e.g. subfield delimiters) pass through,
+			// combining characters without a base character go before
+			// them so that they do not modify the next subfield code.
+			for _, c := range pending {
+				sb.WriteRune(c)
+			}
+			pending = nil
+			sb.WriteByte(b)
+			i++
+			continue
+		}
+
+		if b >= 0x80 && b < 0xa0 {
+			switch b {
+			case 0x88:
+				flush(0x98) // non-sort begin
+			case 0x89:
+				flush(0x9c) // non-sort end
+			case 0x8d:
+				flush(0x200d) // zero width joiner
+			case 0x8e:
+				flush(0x200c) // zero width non-joiner
+			}
+			i++
+			continue
+		}
+
+		if b == 0x20 || b == 0xa0 {
+			flush(' ')
+			i++
+			continue
+		}
+
+		set := d.g0
+		if b >= 0x80 {
+			set = d.g1
+		}
+
+		if b == '&' && set == charsetBasicLatin {
+			if r, n := numericCharRef(data[i:]); n > 0 {
+				flush(r)
+				i += n
+				continue
+			}
+		}
+
+		if set == charsetCJK {
+			if i+3 > len(data) {
+				flush(utf8.RuneError)
+				mapped = false
+				break
+			}
+			code := int(data[i]&0x7f)<<16 | int(data[i+1]&0x7f)<<8 | int(data[i+2]&0x7f)
+			c, ok := marc8CJK[code]
+			if !ok {
+				c = marc8Char{utf8.RuneError, false}
+				mapped = false
+			}
+			flush(c.r)
+			i += 3
+			continue
+		}
+
+		c, ok := marc8Lookup(set, b&0x7f)
+		if !ok {
+			c = marc8Char{utf8.RuneError, false}
+			mapped = false
+		}
+		if c.combining {
+			pending = append(pending, c.r)
+		} else {
+			flush(c.r)
+		}
+		i++
+	}
+
+	// combining characters without a base character
+	for _, c := range pending {
+		sb.WriteRune(c)
+	}
+	return sb.String(), mapped
+}
+
+// numericCharRef parses the hexadecimal numeric character reference
+// (e.g. &#x4E2D;) at the beginning of data and returns the character and
+// the number of bytes used, or zero bytes if there is no valid reference.
+func numericCharRef(data []byte) (rune, int) {
+	if len(data) < 5 || data[1] != '#' || (data[2] != 'x' && data[2] != 'X') {
+		return 0, 0
+	}
+	r := rune(0)
+	for i := 3; i < len(data) && i < 10; i++ {
+		c := data[i]
+		switch {
+		case c == ';' && i > 3:
+			if !utf8.ValidRune(r) {
+				return 0, 0
+			}
+			return r, i + 1
+		case c >= '0' && c <= '9':
+			r = r<<4 | rune(c-'0')
+		case c >= 'a' && c <= 'f':
+			r = r<<4 | rune(c-'a'+10)
+		case c >= 'A' && c <= 'F':
+			r = r<<4 | rune(c-'A'+10)
+		default:
+			return 0, 0
+		}
+	}
+	return 0, 0
+}
+
+func marc8Lookup(set byte, b byte) (marc8Char, bool) {
+	if set == charsetBasicLatin {
+		return marc8Char{rune(b), false}, true
+	}
+	c, ok := marc8Sets[set][b]
+	return c, ok
+}
+
+// escape processes the escape sequence at the beginning of data and
+// returns the number of bytes consumed.
+func (d *marc8Decoder) escape(data []byte) int {
+	if len(data) < 2 {
+		return len(data)
+	}
+
+	switch data[1] {
+	case charsetGreekSymbols, charsetSubscripts, charsetSuperscripts:
+		// technique 1: ESC F designates G0
+		d.g0 = data[1]
+		return 2
+	case 's':
+		d.g0 = charsetBasicLatin
+		return 2
+	case '(', ',', ')', '-':
+		// technique 2, single byte sets: ESC ( F or ESC ) F
+		if len(data) < 3 {
+			return len(data)
+		}
+		set, n := data[2], 3
+		if set == '!' && len(data) > 3 {
+			// ANSEL is designated with two characters, !E
+			set, n = data[3], 4
+		}
+		if data[1] == '(' || data[1] == ',' {
+			d.g0 = set
+		} else {
+			d.g1 = set
+		}
+		return n
+	case '$':
+		// technique 2, multibyte sets: ESC $ 1, ESC $ , 1 or ESC $ ) 1
+		if len(data) < 3 {
+			return len(data)
+		}
+		if data[2] == charsetCJK {
+			d.g0 = charsetCJK
+			return 3
+		}
+		if len(data) < 4 {
+			return len(data)
+		}
+		if data[2] == '(' || data[2] == ',' {
+			d.g0 = data[3]
+		} else {
+			d.g1 = data[3]
+		}
+		return 4
+	}
+
+	// not a valid escape sequence, skip the escape character
+	return 1
+}
+
+// UnmappableCharError is returned when a character cannot be
+// represented in MARC-8.
+type UnmappableCharError struct {
+	Char rune
+}
+
+func (e *UnmappableCharError) Error() string {
+	return fmt.Sprintf("character %q (U+%04X) cannot be represented in MARC-8", e.Char, e.Char)
+}
+
+// marc8Target is the MARC-8 character set and value for a Unicode
+// character. The value for CJK characters uses three bytes.
+type marc8Target struct {
+	set       byte
+	code      int
+	combining bool
+}
+
+var marc8Reverse map[rune]marc8Target
+var marc8ReverseOnce sync.Once
+
+// reverseMarc8 builds (once) the map from Unicode to MARC-8 out of the
+// decoding tables. ASCII takes precedence over the other sets since
+// most non-Latin sets duplicate the ASCII punctuation.
+func reverseMarc8() map[rune]marc8Target {
+	marc8ReverseOnce.Do(func() {
+		m := map[rune]marc8Target{}
+		sets := []byte{charsetExtendedLatin, charsetBasicCyrillic, charsetExtendedCyrillic,
+			charsetBasicHebrew, charsetBasicArabic, charsetBasicGreek, charsetGreekSymbols,
+			charsetSubscripts, charsetSuperscripts}
+		for _, set := range sets {
+			for b, c := range marc8Sets[set] {
+				if c.r < 0x80 {
+					continue
+				}
+				if _, ok := m[c.r]; !ok {
+					m[c.r] = marc8Target{set: set, code: int(b), combining: c.combining}
+				}
+			}
+		}
+		for code, c := range marc8CJK {
+			m[c.r] = marc8Target{set: charsetCJK, code: code}
+		}
+		marc8Reverse = m
+	})
+	return marc8Reverse
+}
+
+// Marc8Encoder converts UTF-8 data to MARC-8.
+//
+// Characters that cannot be represented in MARC-8 are recorded in
+// Unmapped. When Substitute is true they are replaced with a numeric
+// character reference (e.g. &#x20AC;) as suggested by the MARC 21
+// lossless conversion guidelines, otherwise encoding fails with an
+// UnmappableCharError.
+type Marc8Encoder struct {
+	Substitute bool
+	Unmapped   map[rune]int
+}
+
+// NewMarc8Encoder creates an encoder to convert UTF-8 data to MARC-8.
+func NewMarc8Encoder(substitute bool) *Marc8Encoder {
+	return &Marc8Encoder{Substitute: substitute, Unmapped: map[rune]int{}}
+}
+
+// Encode converts a UTF-8 string into MARC-8. Precomposed characters
+// that MARC-8 cannot represent are decomposed (canonical decomposition,
+// as in NFD) and their combining diacritics are placed before the base
+// character. Escape sequences are used for non-Latin characters
+// and the data always ends with ASCII designated as G0.
+func (e *Marc8Encoder) Encode(s string) ([]byte, error) {
+	reverse := reverseMarc8()
+
+	// Decompose the precomposed characters first
+	runes := []rune{}
+	for _, r := range s {
+		runes = decompose(runes, r, reverse)
+	}
+
+	var buf bytes.Buffer
+	g0 := byte(charsetBasicLatin)
+	designate := func(set byte) {
+		if set == g0 {
+			return
+		}
+		switch set {
+		case charsetBasicLatin:
+			if g0 == charsetGreekSymbols || g0 == charsetSubscripts || g0 == charsetSuperscripts {
+				buf.Write([]byte{esc, 's'})
+			} else {
+				buf.Write([]byte{esc, '(', charsetBasicLatin})
+			}
+		case charsetGreekSymbols, charsetSubscripts, charsetSuperscripts:
+			buf.Write([]byte{esc, set})
+		case charsetCJK:
+			buf.Write([]byte{esc, '$', charsetCJK})
+		default:
+			buf.Write([]byte{esc, '(', set})
+		}
+		g0 = set
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		if r < 0x20 || r == ' ' {
+			// control characters and spaces are valid in every set
+			buf.WriteByte(byte(r))
+			continue
+		}
+
+		target, ok := reverse[r]
+		if r < 0x80 {
+			target, ok = marc8Target{set: charsetBasicLatin, code: int(r)}, true
+		}
+		if !ok {
+			if err := e.unmapped(r, &buf, designate); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// Collect the combining characters that follow this character
+		// since MARC-8 puts them first.
+		combining := []marc8Target{}
+		j := i + 1
+		for ; j < len(runes); j++ {
+			t, ok := reverse[runes[j]]
+			if !ok || !t.combining {
+				break
+			}
+			combining = append(combining, t)
+		}
+		e.write(&buf, combining, designate)
+		e.write(&buf, []marc8Target{target}, designate)
+		i = j - 1
+	}
+	designate(charsetBasicLatin)
+	return buf.Bytes(), nil
+}
+
+// decompose appends the character to runes, decomposed recursively
+// when it is not available in MARC-8 (e.g. U+1EDB to U+01A1 U+0301
+// since ANSEL has o with horn but not o with horn and acute).
+func decompose(runes []rune, r rune, reverse map[rune]marc8Target) []rune {
+	if _, ok := reverse[r]; ok || r < 0x80 {
+		return append(runes, r)
+	}
+	d, ok := marc8Decompositions[r]
+	if !ok {
+		return append(runes, r)
+	}
+	for _, c := range d {
+		runes = decompose(runes, c, reverse)
+	}
+	return runes
+}
+
+func (e *Marc8Encoder) write(buf *bytes.Buffer, targets []marc8Target, designate func(byte)) {
+	for _, t := range targets {
+		switch t.set {
+		case charsetExtendedLatin:
+			// ANSEL is always designated as G1
+			buf.WriteByte(byte(t.code) | 0x80)
+		case charsetCJK:
+			designate(t.set)
+			buf.Write([]byte{byte(t.code >> 16), byte(t.code >> 8), byte(t.code)})
+		default:
+			designate(t.set)
+			buf.WriteByte(byte(t.code))
+		}
+	}
+}
+
+func (e *Marc8Encoder) unmapped(r rune, buf *bytes.Buffer, designate func(byte)) error {
+	e.Unmapped[r]++
+	if !e.Substitute {
+		return &UnmappableCharError{Char: r}
+	}
+	designate(charsetBasicLatin)
+	buf.WriteString(fmt.Sprintf("&#x%04X;", r))
+	return nil
+}
+
+// EncodeRecord returns a copy of the record with its values converted
+// to MARC-8, a blank in leader/09, and Data holding the new MARC binary
+// representation. Records already in MARC-8 are returned untouched.
+func (e *Marc8Encoder) EncodeRecord(r Record) (Record, error) {
+	if r.Leader.IsMarc8() {
+		return r, nil
+	}
+
+	rec := Record{Leader: r.Leader}
+	for _, field := range r.Fields {
+		f := Field{Tag: field.Tag, Indicator1: field.Indicator1, Indicator2: field.Indicator2}
+		if field.IsControlField() {
+			value, err := e.Encode(field.Value)
+			if err != nil {
+				return r, err
+			}
+			f.Value = string(value)
+		}
+		for _, sub := range field.SubFields {
+			value, err := e.Encode(sub.Value)
+			if err != nil {
+				return r, err
+			}
+			f.SubFields = append(f.SubFields, SubField{Code: sub.Code, Value: string(value)})
+		}
+		rec.Fields = append(rec.Fields, f)
+	}
+
+	if len(rec.Leader.raw) != leaderLength {
+		rec.Leader, _ = NewLeader([]byte(defaultLeader))
+	}
+	rec.Leader.set(9, ' ')
+
+	data, err := rec.binary()
+	if err != nil {
+		return r, err
+	}
+	rec.Data = data
+	// pick up the new record length and base address
+	rec.Leader, _ = NewLeader(append([]byte(nil), data[:leaderLength]...))
+	return rec, nil
+}
@@ -0,0 +1,985 @@
+package marc
+
+// marc8Decompositions maps precomposed characters to their canonical
+// decomposition (one level, e.g. U+1EDB to U+01A1 U+0301) as listed in
+// the Unicode Character Database 14.0. Since ANSEL has no precomposed
+// letters (other than o and u with horn) the encoder decomposes the
+// characters it cannot represent, recursively, to find their base letter
+// and combining diacritics.
+var marc8Decompositions = map[rune]string{
+	0x00C0: "A\u0300",      // LATIN CAPITAL LETTER A WITH GRAVE
+	0x00C1: "A\u0301",      // LATIN CAPITAL LETTER A WITH ACUTE
+	0x00C2: "A\u0302",      // LATIN CAPITAL LETTER A WITH CIRCUMFLEX
+	0x00C3: "A\u0303",      // LATIN CAPITAL LETTER A WITH TILDE
+	0x00C4: "A\u0308",      // LATIN CAPITAL LETTER A WITH DIAERESIS
+	0x00C5: "A\u030A",      // LATIN CAPITAL LETTER A WITH RING ABOVE
+	0x00C7: "C\u0327",      // LATIN CAPITAL LETTER C WITH CEDILLA
+	0x00C8: "E\u0300",      // LATIN CAPITAL LETTER E WITH GRAVE
+	0x00C9: "E\u0301",      // LATIN CAPITAL LETTER E WITH ACUTE
+	0x00CA: "E\u0302",      // LATIN CAPITAL LETTER E WITH CIRCUMFLEX
+	0x00CB: "E\u0308",      // LATIN CAPITAL LETTER E WITH DIAERESIS
+	0x00CC: "I\u0300",      // LATIN CAPITAL LETTER I WITH GRAVE
+	0x00CD: "I\u0301",      // LATIN CAPITAL LETTER I WITH ACUTE
+	0x00CE: "I\u0302",      // LATIN CAPITAL LETTER I WITH CIRCUMFLEX
+	0x00CF: "I\u0308",      // LATIN CAPITAL LETTER I WITH DIAERESIS
+	0x00D1: "N\u0303",      // LATIN CAPITAL LETTER N WITH TILDE
+	0x00D2: "O\u0300",      // LATIN CAPITAL LETTER O WITH GRAVE
+	0x00D3: "O\u0301",      // LATIN CAPITAL LETTER O WITH ACUTE
+	0x00D4: "O\u0302",      // LATIN CAPITAL LETTER O WITH CIRCUMFLEX
+	0x00D5: "O\u0303",      // LATIN CAPITAL LETTER O WITH TILDE
+	0x00D6: "O\u0308",      // LATIN CAPITAL LETTER O WITH DIAERESIS
+	0x00D9: "U\u0300",      // LATIN CAPITAL LETTER U WITH GRAVE
+	0x00DA: "U\u0301",      // LATIN CAPITAL LETTER U WITH ACUTE
+	0x00DB: "U\u0302",      // LATIN CAPITAL LETTER U WITH CIRCUMFLEX
+	0x00DC: "U\u0308",      // LATIN CAPITAL LETTER U WITH DIAERESIS
+	0x00DD: "Y\u0301",      // LATIN CAPITAL LETTER Y WITH ACUTE
+	0x00E0: "a\u0300",      // LATIN SMALL LETTER A WITH GRAVE
+	0x00E1: "a\u0301",      // LATIN SMALL LETTER A WITH ACUTE
+	0x00E2: "a\u0302",      // LATIN SMALL LETTER A WITH CIRCUMFLEX
+	0x00E3: "a\u0303",      // LATIN SMALL LETTER A WITH TILDE
+	0x00E4: "a\u0308",      // LATIN SMALL LETTER A WITH DIAERESIS
+	0x00E5: "a\u030A",      // LATIN SMALL LETTER A WITH RING ABOVE
+	0x00E7: "c\u0327",      // LATIN SMALL LETTER C WITH CEDILLA
+	0x00E8: "e\u0300",      // LATIN SMALL LETTER E WITH GRAVE
+	0x00E9: "e\u0301",      // LATIN SMALL LETTER E WITH ACUTE
+	0x00EA: "e\u0302",      // LATIN SMALL LETTER E WITH CIRCUMFLEX
+	0x00EB: "e\u0308",      // LATIN SMALL LETTER E WITH DIAERESIS
+	0x00EC: "i\u0300",      // LATIN SMALL LETTER I WITH GRAVE
+	0x00ED: "i\u0301",      // LATIN SMALL LETTER I WITH ACUTE
+	0x00EE: "i\u0302",      // LATIN SMALL LETTER I WITH CIRCUMFLEX
+	0x00EF: "i\u0308",      // LATIN SMALL LETTER I WITH DIAERESIS
+	0x00F1: "n\u0303",      // LATIN SMALL LETTER N WITH TILDE
+	0x00F2: "o\u0300",      // LATIN SMALL LETTER O WITH GRAVE
+	0x00F3: "o\u0301",      // LATIN SMALL LETTER O WITH ACUTE
+	0x00F4: "o\u0302",      // LATIN SMALL LETTER O WITH CIRCUMFLEX
+	0x00F5: "o\u0303",      // LATIN SMALL LETTER O WITH TILDE
+	0x00F6: "o\u0308",      // LATIN SMALL LETTER O WITH DIAERESIS
+	0x00F9: "u\u0300",      // LATIN SMALL LETTER U WITH GRAVE
+	0x00FA: "u\u0301",      // LATIN SMALL LETTER U WITH ACUTE
+	0x00FB: "u\u0302",      // LATIN SMALL LETTER U WITH CIRCUMFLEX
+	0x00FC: "u\u0308",      // LATIN SMALL LETTER U WITH DIAERESIS
+	0x00FD: "y\u0301",      // LATIN SMALL LETTER Y WITH ACUTE
+	0x00FF: "y\u0308",      // LATIN SMALL LETTER Y WITH DIAERESIS
+	0x0100: "A\u0304",      // LATIN CAPITAL LETTER A WITH MACRON
+	0x0101: "a\u0304",      // LATIN SMALL LETTER A WITH MACRON
+	0x0102: "A\u0306",      // LATIN CAPITAL LETTER A WITH BREVE
+	0x0103: "a\u0306",      // LATIN SMALL LETTER A WITH BREVE
+	0x0104: "A\u0328",      // LATIN CAPITAL LETTER A WITH OGONEK
+	0x0105: "a\u0328",      // LATIN SMALL LETTER A WITH OGONEK
+	0x0106: "C\u0301",      // LATIN CAPITAL LETTER C WITH ACUTE
+	0x0107: "c\u0301",      // LATIN SMALL LETTER C WITH ACUTE
+	0x0108: "C\u0302",      // LATIN CAPITAL LETTER C WITH CIRCUMFLEX
+	0x0109: "c\u0302",      // LATIN SMALL LETTER C WITH CIRCUMFLEX
+	0x010A: "C\u0307",      // LATIN CAPITAL LETTER C WITH DOT ABOVE
+	0x010B: "c\u0307",      // LATIN SMALL LETTER C WITH DOT ABOVE
+	0x010C: "C\u030C",      // LATIN CAPITAL LETTER C WITH CARON
+	0x010D: "c\u030C",      // LATIN SMALL LETTER C WITH CARON
+	0x010E: "D\u030C",      // LATIN CAPITAL LETTER D WITH CARON
+	0x010F: "d\u030C",      // LATIN SMALL LETTER D WITH CARON
+	0x0112: "E\u0304",      // LATIN CAPITAL LETTER E WITH MACRON
+	0x0113: "e\u0304",      // LATIN SMALL LETTER E WITH MACRON
+	0x0114: "E\u0306",      // LATIN CAPITAL LETTER E WITH BREVE
+	0x0115: "e\u0306",      // LATIN SMALL LETTER E WITH BREVE
+	0x0116: "E\u0307",      // LATIN CAPITAL LETTER E WITH DOT ABOVE
+	0x0117: "e\u0307",      // LATIN SMALL LETTER E WITH DOT ABOVE
+	0x0118: "E\u0328",      // LATIN CAPITAL LETTER E WITH OGONEK
+	0x0119: "e\u0328",      // LATIN SMALL LETTER E WITH OGONEK
+	0x011A: "E\u030C",      // LATIN CAPITAL LETTER E WITH CARON
+	0x011B: "e\u030C",      // LATIN SMALL LETTER E WITH CARON
+	0x011C: "G\u0302",      // LATIN CAPITAL LETTER G WITH CIRCUMFLEX
+	0x011D: "g\u0302",      // LATIN SMALL LETTER G WITH CIRCUMFLEX
+	0x011E: "G\u0306",      // LATIN CAPITAL LETTER G WITH BREVE
+	0x011F: "g\u0306",      // LATIN SMALL LETTER G WITH BREVE
+	0x0120: "G\u0307",      // LATIN CAPITAL LETTER G WITH DOT ABOVE
+	0x0121: "g\u0307",      // LATIN SMALL LETTER G WITH DOT ABOVE
+	0x0122: "G\u0327",      // LATIN CAPITAL LETTER G WITH CEDILLA
+	0x0123: "g\u0327",      // LATIN SMALL LETTER G WITH CEDILLA
+	0x0124: "H\u0302",      // LATIN CAPITAL LETTER H WITH CIRCUMFLEX
+	0x0125: "h\u0302",      // LATIN SMALL LETTER H WITH CIRCUMFLEX
+	0x0128: "I\u0303",      // LATIN CAPITAL LETTER I WITH TILDE
+	0x0129: "i\u0303",      // LATIN SMALL LETTER I WITH TILDE
+	0x012A: "I\u0304",      // LATIN CAPITAL LETTER I WITH MACRON
+	0x012B: "i\u0304",      // LATIN SMALL LETTER I WITH MACRON
+	0x012C: "I\u0306",      // LATIN CAPITAL LETTER I WITH BREVE
+	0x012D: "i\u0306",      // LATIN SMALL LETTER I WITH BREVE
+	0x012E: "I\u0328",      // LATIN CAPITAL LETTER I WITH OGONEK
+	0x012F: "i\u0328",      // LATIN SMALL LETTER I WITH OGONEK
+	0x0130: "I\u0307",      // LATIN CAPITAL LETTER I WITH DOT ABOVE
+	0x0134: "J\u0302",      // LATIN CAPITAL LETTER J WITH CIRCUMFLEX
+	0x0135: "j\u0302",      // LATIN SMALL LETTER J WITH CIRCUMFLEX
+	0x0136: "K\u0327",      // LATIN CAPITAL LETTER K WITH CEDILLA
+	0x0137: "k\u0327",      // LATIN SMALL LETTER K WITH CEDILLA
+	0x0139: "L\u0301",      // LATIN CAPITAL LETTER L WITH ACUTE
+	0x013A: "l\u0301",      // LATIN SMALL LETTER L WITH ACUTE
+	0x013B: "L\u0327",      // LATIN CAPITAL LETTER L WITH CEDILLA
+	0x013C: "l\u0327",      // LATIN SMALL LETTER L WITH CEDILLA
+	0x013D: "L\u030C",      // LATIN CAPITAL LETTER L WITH CARON
+	0x013E: "l\u030C",      // LATIN SMALL LETTER L WITH CARON
+	0x0143: "N\u0301",      // LATIN CAPITAL LETTER N WITH ACUTE
+	0x0144: "n\u0301",      // LATIN SMALL LETTER N WITH ACUTE
+	0x0145: "N\u0327",      // LATIN CAPITAL LETTER N WITH CEDILLA
+	0x0146: "n\u0327",      // LATIN SMALL LETTER N WITH CEDILLA
+	0x0147: "N\u030C",      // LATIN CAPITAL LETTER N WITH CARON
+	0x0148: "n\u030C",      // LATIN SMALL LETTER N WITH CARON
+	0x014C: "O\u0304",      // LATIN CAPITAL LETTER O WITH MACRON
+	0x014D: "o\u0304",      // LATIN SMALL LETTER O WITH MACRON
+	0x014E: "O\u0306",      // LATIN CAPITAL LETTER O WITH BREVE
+	0x014F: "o\u0306",      // LATIN SMALL LETTER O WITH BREVE
+	0x0150: "O\u030B",      // LATIN CAPITAL LETTER O WITH DOUBLE ACUTE
+	0x0151: "o\u030B",      // LATIN SMALL LETTER O WITH DOUBLE ACUTE
+	0x0154: "R\u0301",      // LATIN CAPITAL LETTER R WITH ACUTE
+	0x0155: "r\u0301",      // LATIN SMALL LETTER R WITH ACUTE
+	0x0156: "R\u0327",      // LATIN CAPITAL LETTER R WITH CEDILLA
+	0x0157: "r\u0327",      // LATIN SMALL LETTER R WITH CEDILLA
+	0x0158: "R\u030C",      // LATIN CAPITAL LETTER R WITH CARON
+	0x0159: "r\u030C",      // LATIN SMALL LETTER R WITH CARON
+	0x015A: "S\u0301",      // LATIN CAPITAL LETTER S WITH ACUTE
+	0x015B: "s\u0301",      // LATIN SMALL LETTER S WITH ACUTE
+	0x015C: "S\u0302",      // LATIN CAPITAL LETTER S WITH CIRCUMFLEX
+	0x015D: "s\u0302",      // LATIN SMALL LETTER S WITH CIRCUMFLEX
+	0x015E: "S\u0327",      // LATIN CAPITAL LETTER S WITH CEDILLA
+	0x015F: "s\u0327",      // LATIN SMALL LETTER S WITH CEDILLA
+	0x0160: "S\u030C",      // LATIN CAPITAL LETTER S WITH CARON
+	0x0161: "s\u030C",      // LATIN SMALL LETTER S WITH CARON
+	0x0162: "T\u0327",      // LATIN CAPITAL LETTER T WITH CEDILLA
+	0x0163: "t\u0327",      // LATIN SMALL LETTER T WITH CEDILLA
+	0x0164: "T\u030C",      // LATIN CAPITAL LETTER T WITH CARON
+	0x0165: "t\u030C",      // LATIN SMALL LETTER T WITH CARON
+	0x0168: "U\u0303",      // LATIN CAPITAL LETTER U WITH TILDE
+	0x0169: "u\u0303",      // LATIN SMALL LETTER U WITH TILDE
+	0x016A: "U\u0304",      // LATIN CAPITAL LETTER U WITH MACRON
+	0x016B: "u\u0304",      // LATIN SMALL LETTER U WITH MACRON
+	0x016C: "U\u0306",      // LATIN CAPITAL LETTER U WITH BREVE
+	0x016D: "u\u0306",      // LATIN SMALL LETTER U WITH BREVE
+	0x016E: "U\u030A",      // LATIN CAPITAL LETTER U WITH RING ABOVE
+	0x016F: "u\u030A",      // LATIN SMALL LETTER U WITH RING ABOVE
+	0x0170: "U\u030B",      // LATIN CAPITAL LETTER U WITH DOUBLE ACUTE
+	0x0171: "u\u030B",      // LATIN SMALL LETTER U WITH DOUBLE ACUTE
+	0x0172: "U\u0328",      // LATIN CAPITAL LETTER U WITH OGONEK
+	0x0173: "u\u0328",      // LATIN SMALL LETTER U WITH OGONEK
+	0x0174: "W\u0302",      // LATIN CAPITAL LETTER W WITH CIRCUMFLEX
+	0x0175: "w\u0302",      // LATIN SMALL LETTER W WITH CIRCUMFLEX
+	0x0176: "Y\u0302",      // LATIN CAPITAL LETTER Y WITH CIRCUMFLEX
+	0x0177: "y\u0302",      // LATIN SMALL LETTER Y WITH CIRCUMFLEX
+	0x0178: "Y\u0308",      // LATIN CAPITAL LETTER Y WITH DIAERESIS
+	0x0179: "Z\u0301",      // LATIN CAPITAL LETTER Z WITH ACUTE
+	0x017A: "z\u0301",      // LATIN SMALL LETTER Z WITH ACUTE
+	0x017B: "Z\u0307",      // LATIN CAPITAL LETTER Z WITH DOT ABOVE
+	0x017C: "z\u0307",      // LATIN SMALL LETTER Z WITH DOT ABOVE
+	0x017D: "Z\u030C",      // LATIN CAPITAL LETTER Z WITH CARON
+	0x017E: "z\u030C",      // LATIN SMALL LETTER Z WITH CARON
+	0x01A0: "O\u031B",      // LATIN CAPITAL LETTER O WITH HORN
+	0x01A1: "o\u031B",      // LATIN SMALL LETTER O WITH HORN
+	0x01AF: "U\u031B",      // LATIN CAPITAL LETTER U WITH HORN
+	0x01B0: "u\u031B",      // LATIN SMALL LETTER U WITH HORN
+	0x01CD: "A\u030C",      // LATIN CAPITAL LETTER A WITH CARON
+	0x01CE: "a\u030C",      // LATIN SMALL LETTER A WITH CARON
+	0x01CF: "I\u030C",      // LATIN CAPITAL LETTER I WITH CARON
+	0x01D0: "i\u030C",      // LATIN SMALL LETTER I WITH CARON
+	0x01D1: "O\u030C",      // LATIN CAPITAL LETTER O WITH CARON
+	0x01D2: "o\u030C",      // LATIN SMALL LETTER O WITH CARON
+	0x01D3: "U\u030C",      // LATIN CAPITAL LETTER U WITH CARON
+	0x01D4: "u\u030C",      // LATIN SMALL LETTER U WITH CARON
+	0x01D5: "\u00DC\u0304", // LATIN CAPITAL LETTER U WITH DIAERESIS AND MACRON
+	0x01D6: "\u00FC\u0304", // LATIN SMALL LETTER U WITH DIAERESIS AND MACRON
+	0x01D7: "\u00DC\u0301", // LATIN CAPITAL LETTER U WITH DIAERESIS AND ACUTE
+	0x01D8: "\u00FC\u0301", // LATIN SMALL LETTER U WITH DIAERESIS AND ACUTE
+	0x01D9: "\u00DC\u030C", // LATIN CAPITAL LETTER U WITH DIAERESIS AND CARON
+	0x01DA: "\u00FC\u030C", // LATIN SMALL LETTER U WITH DIAERESIS AND CARON
+	0x01DB: "\u00DC\u0300", // LATIN CAPITAL LETTER U WITH DIAERESIS AND GRAVE
+	0x01DC: "\u00FC\u0300", // LATIN SMALL LETTER U WITH DIAERESIS AND GRAVE
+	0x01DE: "\u00C4\u0304", // LATIN CAPITAL LETTER A WITH DIAERESIS AND MACRON
+	0x01DF: "\u00E4\u0304", // LATIN SMALL LETTER A WITH DIAERESIS AND MACRON
+	0x01E0: "\u0226\u0304", // LATIN CAPITAL LETTER A WITH DOT ABOVE AND MACRON
+	0x01E1: "\u0227\u0304", // LATIN SMALL LETTER A WITH DOT ABOVE AND MACRON
+	0x01E2: "\u00C6\u0304", // LATIN CAPITAL LETTER AE WITH MACRON
+	0x01E3: "\u00E6\u0304", // LATIN SMALL LETTER AE WITH MACRON
+	0x01E6: "G\u030C",      // LATIN CAPITAL LETTER G WITH CARON
+	0x01E7: "g\u030C",      // LATIN SMALL LETTER G WITH CARON
+	0x01E8: "K\u030C",      // LATIN CAPITAL LETTER K WITH CARON
+	0x01E9: "k\u030C",      // LATIN SMALL LETTER K WITH CARON
+	0x01EA: "O\u0328",      // LATIN CAPITAL LETTER O WITH OGONEK
+	0x01EB: "o\u0328",      // LATIN SMALL LETTER O WITH OGONEK
+	0x01EC: "\u01EA\u0304", // LATIN CAPITAL LETTER O WITH OGONEK AND MACRON
+	0x01ED: "\u01EB\u0304", // LATIN SMALL LETTER O WITH OGONEK AND MACRON
+	0x01EE: "\u01B7\u030C", // LATIN CAPITAL LETTER EZH WITH CARON
+	0x01EF: "\u0292\u030C", // LATIN SMALL LETTER EZH WITH CARON
+	0x01F0: "j\u030C",      // LATIN SMALL LETTER J WITH CARON
+	0x01F4: "G\u0301",      // LATIN CAPITAL LETTER G WITH ACUTE
+	0x01F5: "g\u0301",      // LATIN SMALL LETTER G WITH ACUTE
+	0x01F8: "N\u0300",      // LATIN CAPITAL LETTER N WITH GRAVE
+	0x01F9: "n\u0300",      // LATIN SMALL LETTER N WITH GRAVE
+	0x01FA: "\u00C5\u0301", // LATIN CAPITAL LETTER A WITH RING ABOVE AND ACUTE
+	0x01FB: "\u00E5\u0301", // LATIN SMALL LETTER A WITH RING ABOVE AND ACUTE
+	0x01FC: "\u00C6\u0301", // LATIN CAPITAL LETTER AE WITH ACUTE
+	0x01FD: "\u00E6\u0301", // LATIN SMALL LETTER AE WITH ACUTE
+	0x01FE: "\u00D8\u0301", // LATIN CAPITAL LETTER O WITH STROKE AND ACUTE
+	0x01FF: "\u00F8\u0301", // LATIN SMALL LETTER O WITH STROKE AND ACUTE
+	0x0200: "A\u030F",      // LATIN CAPITAL LETTER A WITH DOUBLE GRAVE
+	0x0201: "a\u030F",      // LATIN SMALL LETTER A WITH DOUBLE GRAVE
+	0x0202: "A\u0311",      // LATIN CAPITAL LETTER A WITH INVERTED BREVE
+	0x0203: "a\u0311",      // LATIN SMALL LETTER A WITH INVERTED BREVE
+	0x0204: "E\u030F",      // LATIN CAPITAL LETTER E WITH DOUBLE GRAVE
+	0x0205: "e\u030F",      // LATIN SMALL LETTER E WITH DOUBLE GRAVE
+	0x0206: "E\u0311",      // LATIN CAPITAL LETTER E WITH INVERTED BREVE
+	0x0207: "e\u0311",      // LATIN SMALL LETTER E WITH INVERTED BREVE
+	0x0208: "I\u030F",      // LATIN CAPITAL LETTER I WITH DOUBLE GRAVE
+	0x0209: "i\u030F",      // LATIN SMALL LETTER I WITH DOUBLE GRAVE
+	0x020A: "I\u0311",      // LATIN CAPITAL LETTER I WITH INVERTED BREVE
+	0x020B: "i\u0311",      // LATIN SMALL LETTER I WITH INVERTED BREVE
+	0x020C: "O\u030F",      // LATIN CAPITAL LETTER O WITH DOUBLE GRAVE
+	0x020D: "o\u030F",      // LATIN SMALL LETTER O WITH DOUBLE GRAVE
+	0x020E: "O\u0311",      // LATIN CAPITAL LETTER O WITH INVERTED BREVE
+	0x020F: "o\u0311",      // LATIN SMALL LETTER O WITH INVERTED BREVE
+	0x0210: "R\u030F",      // LATIN CAPITAL LETTER R WITH DOUBLE GRAVE
+	0x0211: "r\u030F",      // LATIN SMALL LETTER R WITH DOUBLE GRAVE
+	0x0212: "R\u0311",      // LATIN CAPITAL LETTER R WITH INVERTED BREVE
+	0x0213: "r\u0311",      // LATIN SMALL LETTER R WITH INVERTED BREVE
+	0x0214: "U\u030F",      // LATIN CAPITAL LETTER U WITH DOUBLE GRAVE
+	0x0215: "u\u030F",      // LATIN SMALL LETTER U WITH DOUBLE GRAVE
+	0x0216: "U\u0311",      // LATIN CAPITAL LETTER U WITH INVERTED BREVE
+	0x0217: "u\u0311",      // LATIN SMALL LETTER U WITH INVERTED BREVE
+	0x0218: "S\u0326",      // LATIN CAPITAL LETTER S WITH COMMA BELOW
+	0x0219: "s\u0326",      // LATIN SMALL LETTER S WITH COMMA BELOW
+	0x021A: "T\u0326",      // LATIN CAPITAL LETTER T WITH COMMA BELOW
+	0x021B: "t\u0326",      // LATIN SMALL LETTER T WITH COMMA BELOW
+	0x021E: "H\u030C",      // LATIN CAPITAL LETTER H WITH CARON
+	0x021F: "h\u030C",      // LATIN SMALL LETTER H WITH CARON
+	0x0226: "A\u0307",      // LATIN CAPITAL LETTER A WITH DOT ABOVE
+	0x0227: "a\u0307",      // LATIN SMALL LETTER A WITH DOT ABOVE
+	0x0228: "E\u0327",      // LATIN CAPITAL LETTER E WITH CEDILLA
+	0x0229: "e\u0327",      // LATIN SMALL LETTER E WITH CEDILLA
+	0x022A: "\u00D6\u0304", // LATIN CAPITAL LETTER O WITH DIAERESIS AND MACRON
+	0x022B: "\u00F6\u0304", // LATIN SMALL LETTER O WITH DIAERESIS AND MACRON
+	0x022C: "\u00D5\u0304", // LATIN CAPITAL LETTER O WITH TILDE AND MACRON
+	0x022D: "\u00F5\u0304", // LATIN SMALL LETTER O WITH TILDE AND MACRON
+	0x022E: "O\u0307",      // LATIN CAPITAL LETTER O WITH DOT ABOVE
+	0x022F: "o\u0307",      // LATIN SMALL LETTER O WITH DOT ABOVE
+	0x0230: "\u022E\u0304", // LATIN CAPITAL LETTER O WITH DOT ABOVE AND MACRON
+	0x0231: "\u022F\u0304", // LATIN SMALL LETTER O WITH DOT ABOVE AND MACRON
+	0x0232: "Y\u0304",      // LATIN CAPITAL LETTER Y WITH MACRON
+	0x0233: "y\u0304",      // LATIN SMALL LETTER Y WITH MACRON
+	0x0340: "\u0300",       // COMBINING GRAVE TONE MARK
+	0x0341: "\u0301",       // COMBINING ACUTE TONE MARK
+	0x0343: "\u0313",       // COMBINING GREEK KORONIS
+	0x0344: "\u0308\u0301", // COMBINING GREEK DIALYTIKA TONOS
+	0x0374: "\u02B9",       // GREEK NUMERAL SIGN
+	0x037E: ";",            // GREEK QUESTION MARK
+	0x0385: "\u00A8\u0301", // GREEK DIALYTIKA TONOS
+	0x0386: "\u0391\u0301", // GREEK CAPITAL LETTER ALPHA WITH TONOS
+	0x0387: "\u00B7",       // GREEK ANO TELEIA
+	0x0388: "\u0395\u0301", // GREEK CAPITAL LETTER EPSILON WITH TONOS
+	0x0389: "\u0397\u0301", // GREEK CAPITAL LETTER ETA WITH TONOS
+	0x038A: "\u0399\u0301", // GREEK CAPITAL LETTER IOTA WITH TONOS
+	0x038C: "\u039F\u0301", // GREEK CAPITAL LETTER OMICRON WITH TONOS
+	0x038E: "\u03A5\u0301", // GREEK CAPITAL LETTER UPSILON WITH TONOS
+	0x038F: "\u03A9\u0301", // GREEK CAPITAL LETTER OMEGA WITH TONOS
+	0x0390: "\u03CA\u0301", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND TONOS
+	0x03AA: "\u0399\u0308", // GREEK CAPITAL LETTER IOTA WITH DIALYTIKA
+	0x03AB: "\u03A5\u0308", // GREEK CAPITAL LETTER UPSILON WITH DIALYTIKA
+	0x03AC: "\u03B1\u0301", // GREEK SMALL LETTER ALPHA WITH TONOS
+	0x03AD: "\u03B5\u0301", // GREEK SMALL LETTER EPSILON WITH TONOS
+	0x03AE: "\u03B7\u0301", // GREEK SMALL LETTER ETA WITH TONOS
+	0x03AF: "\u03B9\u0301", // GREEK SMALL LETTER IOTA WITH TONOS
+	0x03B0: "\u03CB\u0301", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND TONOS
+	0x03CA: "\u03B9\u0308", // GREEK SMALL LETTER IOTA WITH DIALYTIKA
+	0x03CB: "\u03C5\u0308", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA
+	0x03CC: "\u03BF\u0301", // GREEK SMALL LETTER OMICRON WITH TONOS
+	0x03CD: "\u03C5\u0301", // GREEK SMALL LETTER UPSILON WITH TONOS
+	0x03CE: "\u03C9\u0301", // GREEK SMALL LETTER OMEGA WITH TONOS
+	0x03D3: "\u03D2\u0301", // GREEK UPSILON WITH ACUTE AND HOOK SYMBOL
+	0x03D4: "\u03D2\u0308", // GREEK UPSILON WITH DIAERESIS AND HOOK SYMBOL
+	0x0400: "\u0415\u0300", // CYRILLIC CAPITAL LETTER IE WITH GRAVE
+	0x0401: "\u0415\u0308", // CYRILLIC CAPITAL LETTER IO
+	0x0403: "\u0413\u0301", // CYRILLIC CAPITAL LETTER GJE
+	0x0407: "\u0406\u0308", // CYRILLIC CAPITAL LETTER YI
+	0x040C: "\u041A\u0301", // CYRILLIC CAPITAL LETTER KJE
+	0x040D: "\u0418\u0300", // CYRILLIC CAPITAL LETTER I WITH GRAVE
+	0x040E: "\u0423\u0306", // CYRILLIC CAPITAL LETTER SHORT U
+	0x0419: "\u0418\u0306", // CYRILLIC CAPITAL LETTER SHORT I
+	0x0439: "\u0438\u0306", // CYRILLIC SMALL LETTER SHORT I
+	0x0450: "\u0435\u0300", // CYRILLIC SMALL LETTER IE WITH GRAVE
+	0x0451: "\u0435\u0308", // CYRILLIC SMALL LETTER IO
+	0x0453: "\u0433\u0301", // CYRILLIC SMALL LETTER GJE
+	0x0457: "\u0456\u0308", // CYRILLIC SMALL LETTER YI
+	0x045C: "\u043A\u0301", // CYRILLIC SMALL LETTER KJE
+	0x045D: "\u0438\u0300", // CYRILLIC SMALL LETTER I WITH GRAVE
+	0x045E: "\u0443\u0306", // CYRILLIC SMALL LETTER SHORT U
+	0x0476: "\u0474\u030F", // CYRILLIC CAPITAL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT
+	0x0477: "\u0475\u030F", // CYRILLIC SMALL LETTER IZHITSA WITH DOUBLE GRAVE ACCENT
+	0x04C1: "\u0416\u0306", // CYRILLIC CAPITAL LETTER ZHE WITH BREVE
+	0x04C2: "\u0436\u0306", // CYRILLIC SMALL LETTER ZHE WITH BREVE
+	0x04D0: "\u0410\u0306", // CYRILLIC CAPITAL LETTER A WITH BREVE
+	0x04D1: "\u0430\u0306", // CYRILLIC SMALL LETTER A WITH BREVE
+	0x04D2: "\u0410\u0308", // CYRILLIC CAPITAL LETTER A WITH DIAERESIS
+	0x04D3: "\u0430\u0308", // CYRILLIC SMALL LETTER A WITH DIAERESIS
+	0x04D6: "\u0415\u0306", // CYRILLIC CAPITAL LETTER IE WITH BREVE
+	0x04D7: "\u0435\u0306", // CYRILLIC SMALL LETTER IE WITH BREVE
+	0x04DA: "\u04D8\u0308", // CYRILLIC CAPITAL LETTER SCHWA WITH DIAERESIS
+	0x04DB: "\u04D9\u0308", // CYRILLIC SMALL LETTER SCHWA WITH DIAERESIS
+	0x04DC: "\u0416\u0308", // CYRILLIC CAPITAL LETTER ZHE WITH DIAERESIS
+	0x04DD: "\u0436\u0308", // CYRILLIC SMALL LETTER ZHE WITH DIAERESIS
+	0x04DE: "\u0417\u0308", // CYRILLIC CAPITAL LETTER ZE WITH DIAERESIS
+	0x04DF: "\u0437\u0308", // CYRILLIC SMALL LETTER ZE WITH DIAERESIS
+	0x04E2: "\u0418\u0304", // CYRILLIC CAPITAL LETTER I WITH MACRON
+	0x04E3: "\u0438\u0304", // CYRILLIC SMALL LETTER I WITH MACRON
+	0x04E4: "\u0418\u0308", // CYRILLIC CAPITAL LETTER I WITH DIAERESIS
+	0x04E5: "\u0438\u0308", // CYRILLIC SMALL LETTER I WITH DIAERESIS
+	0x04E6: "\u041E\u0308", // CYRILLIC CAPITAL LETTER O WITH DIAERESIS
+	0x04E7: "\u043E\u0308", // CYRILLIC SMALL LETTER O WITH DIAERESIS
+	0x04EA: "\u04E8\u0308", // CYRILLIC CAPITAL LETTER BARRED O WITH DIAERESIS
+	0x04EB: "\u04E9\u0308", // CYRILLIC SMALL LETTER BARRED O WITH DIAERESIS
+	0x04EC: "\u042D\u0308", // CYRILLIC CAPITAL LETTER E WITH DIAERESIS
+	0x04ED: "\u044D\u0308", // CYRILLIC SMALL LETTER E WITH DIAERESIS
+	0x04EE: "\u0423\u0304", // CYRILLIC CAPITAL LETTER U WITH MACRON
+	0x04EF: "\u0443\u0304", // CYRILLIC SMALL LETTER U WITH MACRON
+	0x04F0: "\u0423\u0308", // CYRILLIC CAPITAL LETTER U WITH DIAERESIS
+	0x04F1: "\u0443\u0308", // CYRILLIC SMALL LETTER U WITH DIAERESIS
+	0x04F2: "\u0423\u030B", // CYRILLIC CAPITAL LETTER U WITH DOUBLE ACUTE
+	0x04F3: "\u0443\u030B", // CYRILLIC SMALL LETTER U WITH DOUBLE ACUTE
+	0x04F4: "\u0427\u0308", // CYRILLIC CAPITAL LETTER CHE WITH DIAERESIS
+	0x04F5: "\u0447\u0308", // CYRILLIC SMALL LETTER CHE WITH DIAERESIS
+	0x04F8: "\u042B\u0308", // CYRILLIC CAPITAL LETTER YERU WITH DIAERESIS
+	0x04F9: "\u044B\u0308", // CYRILLIC SMALL LETTER YERU WITH DIAERESIS
+	0x0622: "\u0627\u0653", // ARABIC LETTER ALEF WITH MADDA ABOVE
+	0x0623: "\u0627\u0654", // ARABIC LETTER ALEF WITH HAMZA ABOVE
+	0x0624: "\u0648\u0654", // ARABIC LETTER WAW WITH HAMZA ABOVE
+	0x0625: "\u0627\u0655", // ARABIC LETTER ALEF WITH HAMZA BELOW
+	0x0626: "\u064A\u0654", // ARABIC LETTER YEH WITH HAMZA ABOVE
+	0x06C0: "\u06D5\u0654", // ARABIC LETTER HEH WITH YEH ABOVE
+	0x06C2: "\u06C1\u0654", // ARABIC LETTER HEH GOAL WITH HAMZA ABOVE
+	0x06D3: "\u06D2\u0654", // ARABIC LETTER YEH BARREE WITH HAMZA ABOVE
+	0x0929: "\u0928\u093C", // DEVANAGARI LETTER NNNA
+	0x0931: "\u0930\u093C", // DEVANAGARI LETTER RRA
+	0x0934: "\u0933\u093C", // DEVANAGARI LETTER LLLA
+	0x0958: "\u0915\u093C", // DEVANAGARI LETTER QA
+	0x0959: "\u0916\u093C", // DEVANAGARI LETTER KHHA
+	0x095A: "\u0917\u093C", // DEVANAGARI LETTER GHHA
+	0x095B: "\u091C\u093C", // DEVANAGARI LETTER ZA
+	0x095C: "\u0921\u093C", // DEVANAGARI LETTER DDDHA
+	0x095D: "\u0922\u093C", // DEVANAGARI LETTER RHA
+	0x095E: "\u092B\u093C", // DEVANAGARI LETTER FA
+	0x095F: "\u092F\u093C", // DEVANAGARI LETTER YYA
+	0x09CB: "\u09C7\u09BE", // BENGALI VOWEL SIGN O
+	0x09CC: "\u09C7\u09D7", // BENGALI VOWEL SIGN AU
+	0x09DC: "\u09A1\u09BC", // BENGALI LETTER RRA
+	0x09DD: "\u09A2\u09BC", // BENGALI LETTER RHA
+	0x09DF: "\u09AF\u09BC", // BENGALI LETTER YYA
+	0x0A33: "\u0A32\u0A3C", // GURMUKHI LETTER LLA
+	0x0A36: "\u0A38\u0A3C", // GURMUKHI LETTER SHA
+	0x0A59: "\u0A16\u0A3C", // GURMUKHI LETTER KHHA
+	0x0A5A: "\u0A17\u0A3C", // GURMUKHI LETTER GHHA
+	0x0A5B: "\u0A1C\u0A3C", // GURMUKHI LETTER ZA
+	0x0A5E: "\u0A2B\u0A3C", // GURMUKHI LETTER FA
+	0x0B48: "\u0B47\u0B56", // ORIYA VOWEL SIGN AI
+	0x0B4B: "\u0B47\u0B3E", // ORIYA VOWEL SIGN O
+	0x0B4C: "\u0B47\u0B57", // ORIYA VOWEL SIGN AU
+	0x0B5C: "\u0B21\u0B3C", // ORIYA LETTER RRA
+	0x0B5D: "\u0B22\u0B3C", // ORIYA LETTER RHA
+	0x0B94: "\u0B92\u0BD7", // TAMIL LETTER AU
+	0x0BCA: "\u0BC6\u0BBE", // TAMIL VOWEL SIGN O
+	0x0BCB: "\u0BC7\u0BBE", // TAMIL VOWEL SIGN OO
+	0x0BCC: "\u0BC6\u0BD7", // TAMIL VOWEL SIGN AU
+	0x0C48: "\u0C46\u0C56", // TELUGU VOWEL SIGN AI
+	0x0CC0: "\u0CBF\u0CD5", // KANNADA VOWEL SIGN II
+	0x0CC7: "\u0CC6\u0CD5", // KANNADA VOWEL SIGN EE
+	0x0CC8: "\u0CC6\u0CD6", // KANNADA VOWEL SIGN AI
+	0x0CCA: "\u0CC6\u0CC2", // KANNADA VOWEL SIGN O
+	0x0CCB: "\u0CCA\u0CD5", // KANNADA VOWEL SIGN OO
+	0x0D4A: "\u0D46\u0D3E", // MALAYALAM VOWEL SIGN O
+	0x0D4B: "\u0D47\u0D3E", // MALAYALAM VOWEL SIGN OO
+	0x0D4C: "\u0D46\u0D57", // MALAYALAM VOWEL SIGN AU
+	0x0DDA: "\u0DD9\u0DCA", // SINHALA VOWEL SIGN DIGA KOMBUVA
+	0x0DDC: "\u0DD9\u0DCF", // SINHALA VOWEL SIGN KOMBUVA HAA AELA-PILLA
+	0x0DDD: "\u0DDC\u0DCA", // SINHALA VOWEL SIGN KOMBUVA HAA DIGA AELA-PILLA
+	0x0DDE: "\u0DD9\u0DDF", // SINHALA VOWEL SIGN KOMBUVA HAA GAYANUKITTA
+	0x0F43: "\u0F42\u0FB7", // TIBETAN LETTER GHA
+	0x0F4D: "\u0F4C\u0FB7", // TIBETAN LETTER DDHA
+	0x0F52: "\u0F51\u0FB7", // TIBETAN LETTER DHA
+	0x0F57: "\u0F56\u0FB7", // TIBETAN LETTER BHA
+	0x0F5C: "\u0F5B\u0FB7", // TIBETAN LETTER DZHA
+	0x0F69: "\u0F40\u0FB5", // TIBETAN LETTER KSSA
+	0x0F73: "\u0F71\u0F72", // TIBETAN VOWEL SIGN II
+	0x0F75: "\u0F71\u0F74", // TIBETAN VOWEL SIGN UU
+	0x0F76: "\u0FB2\u0F80", // TIBETAN VOWEL SIGN VOCALIC R
+	0x0F78: "\u0FB3\u0F80", // TIBETAN VOWEL SIGN VOCALIC L
+	0x0F81: "\u0F71\u0F80", // TIBETAN VOWEL SIGN REVERSED II
+	0x0F93: "\u0F92\u0FB7", // TIBETAN SUBJOINED LETTER GHA
+	0x0F9D: "\u0F9C\u0FB7", // TIBETAN SUBJOINED LETTER DDHA
+	0x0FA2: "\u0FA1\u0FB7", // TIBETAN SUBJOINED LETTER DHA
+	0x0FA7: "\u0FA6\u0FB7", // TIBETAN SUBJOINED LETTER BHA
+	0x0FAC: "\u0FAB\u0FB7", // TIBETAN SUBJOINED LETTER DZHA
+	0x0FB9: "\u0F90\u0FB5", // TIBETAN SUBJOINED LETTER KSSA
+	0x1026: "\u1025\u102E", // MYANMAR LETTER UU
+	0x1B06: "\u1B05\u1B35", // BALINESE LETTER AKARA TEDUNG
+	0x1B08: "\u1B07\u1B35", // BALINESE LETTER IKARA TEDUNG
+	0x1B0A: "\u1B09\u1B35", // BALINESE LETTER UKARA TEDUNG
+	0x1B0C: "\u1B0B\u1B35", // BALINESE LETTER RA REPA TEDUNG
+	0x1B0E: "\u1B0D\u1B35", // BALINESE LETTER LA LENGA TEDUNG
+	0x1B12: "\u1B11\u1B35", // BALINESE LETTER OKARA TEDUNG
+	0x1B3B: "\u1B3A\u1B35", // BALINESE VOWEL SIGN RA REPA TEDUNG
+	0x1B3D: "\u1B3C\u1B35", // BALINESE VOWEL SIGN LA LENGA TEDUNG
+	0x1B40: "\u1B3E\u1B35", // BALINESE VOWEL SIGN TALING TEDUNG
+	0x1B41: "\u1B3F\u1B35", // BALINESE VOWEL SIGN TALING REPA TEDUNG
+	0x1B43: "\u1B42\u1B35", // BALINESE VOWEL SIGN PEPET TEDUNG
+	0x1E00: "A\u0325",      // LATIN CAPITAL LETTER A WITH RING BELOW
+	0x1E01: "a\u0325",      // LATIN SMALL LETTER A WITH RING BELOW
+	0x1E02: "B\u0307",      // LATIN CAPITAL LETTER B WITH DOT ABOVE
+	0x1E03: "b\u0307",      // LATIN SMALL LETTER B WITH DOT ABOVE
+	0x1E04: "B\u0323",      // LATIN CAPITAL LETTER B WITH DOT BELOW
+	0x1E05: "b\u0323",      // LATIN SMALL LETTER B WITH DOT BELOW
+	0x1E06: "B\u0331",      // LATIN CAPITAL LETTER B WITH LINE BELOW
+	0x1E07: "b\u0331",      // LATIN SMALL LETTER B WITH LINE BELOW
+	0x1E08: "\u00C7\u0301", // LATIN CAPITAL LETTER C WITH CEDILLA AND ACUTE
+	0x1E09: "\u00E7\u0301", // LATIN SMALL LETTER C WITH CEDILLA AND ACUTE
+	0x1E0A: "D\u0307",      // LATIN CAPITAL LETTER D WITH DOT ABOVE
+	0x1E0B: "d\u0307",      // LATIN SMALL LETTER D WITH DOT ABOVE
+	0x1E0C: "D\u0323",      // LATIN CAPITAL LETTER D WITH DOT BELOW
+	0x1E0D: "d\u0323",      // LATIN SMALL LETTER D WITH DOT BELOW
+	0x1E0E: "D\u0331",      // LATIN CAPITAL LETTER D WITH LINE BELOW
+	0x1E0F: "d\u0331",      // LATIN SMALL LETTER D WITH LINE BELOW
+	0x1E10: "D\u0327",      // LATIN CAPITAL LETTER D WITH CEDILLA
+	0x1E11: "d\u0327",      // LATIN SMALL LETTER D WITH CEDILLA
+	0x1E12: "D\u032D",      // LATIN CAPITAL LETTER D WITH CIRCUMFLEX BELOW
+	0x1E13: "d\u032D",      // LATIN SMALL LETTER D WITH CIRCUMFLEX BELOW
+	0x1E14: "\u0112\u0300", // LATIN CAPITAL LETTER E WITH MACRON AND GRAVE
+	0x1E15: "\u0113\u0300", // LATIN SMALL LETTER E WITH MACRON AND GRAVE
+	0x1E16: "\u0112\u0301", // LATIN CAPITAL LETTER E WITH MACRON AND ACUTE
+	0x1E17: "\u0113\u0301", // LATIN SMALL LETTER E WITH MACRON AND ACUTE
+	0x1E18: "E\u032D",      // LATIN CAPITAL LETTER E WITH CIRCUMFLEX BELOW
+	0x1E19: "e\u032D",      // LATIN SMALL LETTER E WITH CIRCUMFLEX BELOW
+	0x1E1A: "E\u0330",      // LATIN CAPITAL LETTER E WITH TILDE BELOW
+	0x1E1B: "e\u0330",      // LATIN SMALL LETTER E WITH TILDE BELOW
+	0x1E1C: "\u0228\u0306", // LATIN CAPITAL LETTER E WITH CEDILLA AND BREVE
+	0x1E1D: "\u0229\u0306", // LATIN SMALL LETTER E WITH CEDILLA AND BREVE
+	0x1E1E: "F\u0307",      // LATIN CAPITAL LETTER F WITH DOT ABOVE
+	0x1E1F: "f\u0307",      // LATIN SMALL LETTER F WITH DOT ABOVE
+	0x1E20: "G\u0304",      // LATIN CAPITAL LETTER G WITH MACRON
+	0x1E21: "g\u0304",      // LATIN SMALL LETTER G WITH MACRON
+	0x1E22: "H\u0307",      // LATIN CAPITAL LETTER H WITH DOT ABOVE
+	0x1E23: "h\u0307",      // LATIN SMALL LETTER H WITH DOT ABOVE
+	0x1E24: "H\u0323",      // LATIN CAPITAL LETTER H WITH DOT BELOW
+	0x1E25: "h\u0323",      // LATIN SMALL LETTER H WITH DOT BELOW
+	0x1E26: "H\u0308",      // LATIN CAPITAL LETTER H WITH DIAERESIS
+	0x1E27: "h\u0308",      // LATIN SMALL LETTER H WITH DIAERESIS
+	0x1E28: "H\u0327",      // LATIN CAPITAL LETTER H WITH CEDILLA
+	0x1E29: "h\u0327",      // LATIN SMALL LETTER H WITH CEDILLA
+	0x1E2A: "H\u032E",      // LATIN CAPITAL LETTER H WITH BREVE BELOW
+	0x1E2B: "h\u032E",      // LATIN SMALL LETTER H WITH BREVE BELOW
+	0x1E2C: "I\u0330",      // LATIN CAPITAL LETTER I WITH TILDE BELOW
+	0x1E2D: "i\u0330",      // LATIN SMALL LETTER I WITH TILDE BELOW
+	0x1E2E: "\u00CF\u0301", // LATIN CAPITAL LETTER I WITH DIAERESIS AND ACUTE
+	0x1E2F: "\u00EF\u0301", // LATIN SMALL LETTER I WITH DIAERESIS AND ACUTE
+	0x1E30: "K\u0301",      // LATIN CAPITAL LETTER K WITH ACUTE
+	0x1E31: "k\u0301",      // LATIN SMALL LETTER K WITH ACUTE
+	0x1E32: "K\u0323",      // LATIN CAPITAL LETTER K WITH DOT BELOW
+	0x1E33: "k\u0323",      // LATIN SMALL LETTER K WITH DOT BELOW
+	0x1E34: "K\u0331",      // LATIN CAPITAL LETTER K WITH LINE BELOW
+	0x1E35: "k\u0331",      // LATIN SMALL LETTER K WITH LINE BELOW
+	0x1E36: "L\u0323",      // LATIN CAPITAL LETTER L WITH DOT BELOW
+	0x1E37: "l\u0323",      // LATIN SMALL LETTER L WITH DOT BELOW
+	0x1E38: "\u1E36\u0304", // LATIN CAPITAL LETTER L WITH DOT BELOW AND MACRON
+	0x1E39: "\u1E37\u0304", // LATIN SMALL LETTER L WITH DOT BELOW AND MACRON
+	0x1E3A: "L\u0331",      // LATIN CAPITAL LETTER L WITH LINE BELOW
+	0x1E3B: "l\u0331",      // LATIN SMALL LETTER L WITH LINE BELOW
+	0x1E3C: "L\u032D",      // LATIN CAPITAL LETTER L WITH CIRCUMFLEX BELOW
+	0x1E3D: "l\u032D",      // LATIN SMALL LETTER L WITH CIRCUMFLEX BELOW
+	0x1E3E: "M\u0301",      // LATIN CAPITAL LETTER M WITH ACUTE
+	0x1E3F: "m\u0301",      // LATIN SMALL LETTER M WITH ACUTE
+	0x1E40: "M\u0307",      // LATIN CAPITAL LETTER M WITH DOT ABOVE
+	0x1E41: "m\u0307",      // LATIN SMALL LETTER M WITH DOT ABOVE
+	0x1E42: "M\u0323",      // LATIN CAPITAL LETTER M WITH DOT BELOW
+	0x1E43: "m\u0323",      // LATIN SMALL LETTER M WITH DOT BELOW
+	0x1E44: "N\u0307",      // LATIN CAPITAL LETTER N WITH DOT ABOVE
+	0x1E45: "n\u0307",      // LATIN SMALL LETTER N WITH DOT ABOVE
+	0x1E46: "N\u0323",      // LATIN CAPITAL LETTER N WITH DOT BELOW
+	0x1E47: "n\u0323",      // LATIN SMALL LETTER N WITH DOT BELOW
+	0x1E48: "N\u0331",      // LATIN CAPITAL LETTER N WITH LINE BELOW
+	0x1E49: "n\u0331",      // LATIN SMALL LETTER N WITH LINE BELOW
+	0x1E4A: "N\u032D",      // LATIN CAPITAL LETTER N WITH CIRCUMFLEX BELOW
+	0x1E4B: "n\u032D",      // LATIN SMALL LETTER N WITH CIRCUMFLEX BELOW
+	0x1E4C: "\u00D5\u0301", // LATIN CAPITAL LETTER O WITH TILDE AND ACUTE
+	0x1E4D: "\u00F5\u0301", // LATIN SMALL LETTER O WITH TILDE AND ACUTE
+	0x1E4E: "\u00D5\u0308", // LATIN CAPITAL LETTER O WITH TILDE AND DIAERESIS
+	0x1E4F: "\u00F5\u0308", // LATIN SMALL LETTER O WITH TILDE AND DIAERESIS
+	0x1E50: "\u014C\u0300", // LATIN CAPITAL LETTER O WITH MACRON AND GRAVE
+	0x1E51: "\u014D\u0300", // LATIN SMALL LETTER O WITH MACRON AND GRAVE
+	0x1E52: "\u014C\u0301", // LATIN CAPITAL LETTER O WITH MACRON AND ACUTE
+	0x1E53: "\u014D\u0301", // LATIN SMALL LETTER O WITH MACRON AND ACUTE
+	0x1E54: "P\u0301",      // LATIN CAPITAL LETTER P WITH ACUTE
+	0x1E55: "p\u0301",      // LATIN SMALL LETTER P WITH ACUTE
+	0x1E56: "P\u0307",      // LATIN CAPITAL LETTER P WITH DOT ABOVE
+	0x1E57: "p\u0307",      // LATIN SMALL LETTER P WITH DOT ABOVE
+	0x1E58: "R\u0307",      // LATIN CAPITAL LETTER R WITH DOT ABOVE
+	0x1E59: "r\u0307",      // LATIN SMALL LETTER R WITH DOT ABOVE
+	0x1E5A: "R\u0323",      // LATIN CAPITAL LETTER R WITH DOT BELOW
+	0x1E5B: "r\u0323",      // LATIN SMALL LETTER R WITH DOT BELOW
+	0x1E5C: "\u1E5A\u0304", // LATIN CAPITAL LETTER R WITH DOT BELOW AND MACRON
+	0x1E5D: "\u1E5B\u0304", // LATIN SMALL LETTER R WITH DOT BELOW AND MACRON
+	0x1E5E: "R\u0331",      // LATIN CAPITAL LETTER R WITH LINE BELOW
+	0x1E5F: "r\u0331",      // LATIN SMALL LETTER R WITH LINE BELOW
+	0x1E60: "S\u0307",      // LATIN CAPITAL LETTER S WITH DOT ABOVE
+	0x1E61: "s\u0307",      // LATIN SMALL LETTER S WITH DOT ABOVE
+	0x1E62: "S\u0323",      // LATIN CAPITAL LETTER S WITH DOT BELOW
+	0x1E63: "s\u0323",      // LATIN SMALL LETTER S WITH DOT BELOW
+	0x1E64: "\u015A\u0307", // LATIN CAPITAL LETTER S WITH ACUTE AND DOT ABOVE
+	0x1E65: "\u015B\u0307", // LATIN SMALL LETTER S WITH ACUTE AND DOT ABOVE
+	0x1E66: "\u0160\u0307", // LATIN CAPITAL LETTER S WITH CARON AND DOT ABOVE
+	0x1E67: "\u0161\u0307", // LATIN SMALL LETTER S WITH CARON AND DOT ABOVE
+	0x1E68: "\u1E62\u0307", // LATIN CAPITAL LETTER S WITH DOT BELOW AND DOT ABOVE
+	0x1E69: "\u1E63\u0307", // LATIN SMALL LETTER S WITH DOT BELOW AND DOT ABOVE
+	0x1E6A: "T\u0307",      // LATIN CAPITAL LETTER T WITH DOT ABOVE
+	0x1E6B: "t\u0307",      // LATIN SMALL LETTER T WITH DOT ABOVE
+	0x1E6C: "T\u0323",      // LATIN CAPITAL LETTER T WITH DOT BELOW
+	0x1E6D: "t\u0323",      // LATIN SMALL LETTER T WITH DOT BELOW
+	0x1E6E: "T\u0331",      // LATIN CAPITAL LETTER T WITH LINE BELOW
+	0x1E6F: "t\u0331",      // LATIN SMALL LETTER T WITH LINE BELOW
+	0x1E70: "T\u032D",      // LATIN CAPITAL LETTER T WITH CIRCUMFLEX BELOW
+	0x1E71: "t\u032D",      // LATIN SMALL LETTER T WITH CIRCUMFLEX BELOW
+	0x1E72: "U\u0324",      // LATIN CAPITAL LETTER U WITH DIAERESIS BELOW
+	0x1E73: "u\u0324",      // LATIN SMALL LETTER U WITH DIAERESIS BELOW
+	0x1E74: "U\u0330",      // LATIN CAPITAL LETTER U WITH TILDE BELOW
+	0x1E75: "u\u0330",      // LATIN SMALL LETTER U WITH TILDE BELOW
+	0x1E76: "U\u032D",      // LATIN CAPITAL LETTER U WITH CIRCUMFLEX BELOW
+	0x1E77: "u\u032D",      // LATIN SMALL LETTER U WITH CIRCUMFLEX BELOW
+	0x1E78: "\u0168\u0301", // LATIN CAPITAL LETTER U WITH TILDE AND ACUTE
+	0x1E79: "\u0169\u0301", // LATIN SMALL LETTER U WITH TILDE AND ACUTE
+	0x1E7A: "\u016A\u0308", // LATIN CAPITAL LETTER U WITH MACRON AND DIAERESIS
+	0x1E7B: "\u016B\u0308", // LATIN SMALL LETTER U WITH MACRON AND DIAERESIS
+	0x1E7C: "V\u0303",      // LATIN CAPITAL LETTER V WITH TILDE
+	0x1E7D: "v\u0303",      // LATIN SMALL LETTER V WITH TILDE
+	0x1E7E: "V\u0323",      // LATIN CAPITAL LETTER V WITH DOT BELOW
+	0x1E7F: "v\u0323",      // LATIN SMALL LETTER V WITH DOT BELOW
+	0x1E80: "W\u0300",      // LATIN CAPITAL LETTER W WITH GRAVE
+	0x1E81: "w\u0300",      // LATIN SMALL LETTER W WITH GRAVE
+	0x1E82: "W\u0301",      // LATIN CAPITAL LETTER W WITH ACUTE
+	0x1E83: "w\u0301",      // LATIN SMALL LETTER W WITH ACUTE
+	0x1E84: "W\u0308",      // LATIN CAPITAL LETTER W WITH DIAERESIS
+	0x1E85: "w\u0308",      // LATIN SMALL LETTER W WITH DIAERESIS
+	0x1E86: "W\u0307",      // LATIN CAPITAL LETTER W WITH DOT ABOVE
+	0x1E87: "w\u0307",      // LATIN SMALL LETTER W WITH DOT ABOVE
+	0x1E88: "W\u0323",      // LATIN CAPITAL LETTER W WITH DOT BELOW
+	0x1E89: "w\u0323",      // LATIN SMALL LETTER W WITH DOT BELOW
+	0x1E8A: "X\u0307",      // LATIN CAPITAL LETTER X WITH DOT ABOVE
+	0x1E8B: "x\u0307",      // LATIN SMALL LETTER X WITH DOT ABOVE
+	0x1E8C: "X\u0308",      // LATIN CAPITAL LETTER X WITH DIAERESIS
+	0x1E8D: "x\u0308",      // LATIN SMALL LETTER X WITH DIAERESIS
+	0x1E8E: "Y\u0307",      // LATIN CAPITAL LETTER Y WITH DOT ABOVE
+	0x1E8F: "y\u0307",      // LATIN SMALL LETTER Y WITH DOT ABOVE
+	0x1E90: "Z\u0302",      // LATIN CAPITAL LETTER Z WITH CIRCUMFLEX
+	0x1E91: "z\u0302",      // LATIN SMALL LETTER Z WITH CIRCUMFLEX
+	0x1E92: "Z\u0323",      // LATIN CAPITAL LETTER Z WITH DOT BELOW
+	0x1E93: "z\u0323",      // LATIN SMALL LETTER Z WITH DOT BELOW
+	0x1E94: "Z\u0331",      // LATIN CAPITAL LETTER Z WITH LINE BELOW
+	0x1E95: "z\u0331",      // LATIN SMALL LETTER Z WITH LINE BELOW
+	0x1E96: "h\u0331",      // LATIN SMALL LETTER H WITH LINE BELOW
+	0x1E97: "t\u0308",      // LATIN SMALL LETTER T WITH DIAERESIS
+	0x1E98: "w\u030A",      // LATIN SMALL LETTER W WITH RING ABOVE
+	0x1E99: "y\u030A",      // LATIN SMALL LETTER Y WITH RING ABOVE
+	0x1E9B: "\u017F\u0307", // LATIN SMALL LETTER LONG S WITH DOT ABOVE
+	0x1EA0: "A\u0323",      // LATIN CAPITAL LETTER A WITH DOT BELOW
+	0x1EA1: "a\u0323",      // LATIN SMALL LETTER A WITH DOT BELOW
+	0x1EA2: "A\u0309",      // LATIN CAPITAL LETTER A WITH HOOK ABOVE
+	0x1EA3: "a\u0309",      // LATIN SMALL LETTER A WITH HOOK ABOVE
+	0x1EA4: "\u00C2\u0301", // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND ACUTE
+	0x1EA5: "\u00E2\u0301", // LATIN SMALL LETTER A WITH CIRCUMFLEX AND ACUTE
+	0x1EA6: "\u00C2\u0300", // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND GRAVE
+	0x1EA7: "\u00E2\u0300", // LATIN SMALL LETTER A WITH CIRCUMFLEX AND GRAVE
+	0x1EA8: "\u00C2\u0309", // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EA9: "\u00E2\u0309", // LATIN SMALL LETTER A WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EAA: "\u00C2\u0303", // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND TILDE
+	0x1EAB: "\u00E2\u0303", // LATIN SMALL LETTER A WITH CIRCUMFLEX AND TILDE
+	0x1EAC: "\u1EA0\u0302", // LATIN CAPITAL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+	0x1EAD: "\u1EA1\u0302", // LATIN SMALL LETTER A WITH CIRCUMFLEX AND DOT BELOW
+	0x1EAE: "\u0102\u0301", // LATIN CAPITAL LETTER A WITH BREVE AND ACUTE
+	0x1EAF: "\u0103\u0301", // LATIN SMALL LETTER A WITH BREVE AND ACUTE
+	0x1EB0: "\u0102\u0300", // LATIN CAPITAL LETTER A WITH BREVE AND GRAVE
+	0x1EB1: "\u0103\u0300", // LATIN SMALL LETTER A WITH BREVE AND GRAVE
+	0x1EB2: "\u0102\u0309", // LATIN CAPITAL LETTER A WITH BREVE AND HOOK ABOVE
+	0x1EB3: "\u0103\u0309", // LATIN SMALL LETTER A WITH BREVE AND HOOK ABOVE
+	0x1EB4: "\u0102\u0303", // LATIN CAPITAL LETTER A WITH BREVE AND TILDE
+	0x1EB5: "\u0103\u0303", // LATIN SMALL LETTER A WITH BREVE AND TILDE
+	0x1EB6: "\u1EA0\u0306", // LATIN CAPITAL LETTER A WITH BREVE AND DOT BELOW
+	0x1EB7: "\u1EA1\u0306", // LATIN SMALL LETTER A WITH BREVE AND DOT BELOW
+	0x1EB8: "E\u0323",      // LATIN CAPITAL LETTER E WITH DOT BELOW
+	0x1EB9: "e\u0323",      // LATIN SMALL LETTER E WITH DOT BELOW
+	0x1EBA: "E\u0309",      // LATIN CAPITAL LETTER E WITH HOOK ABOVE
+	0x1EBB: "e\u0309",      // LATIN SMALL LETTER E WITH HOOK ABOVE
+	0x1EBC: "E\u0303",      // LATIN CAPITAL LETTER E WITH TILDE
+	0x1EBD: "e\u0303",      // LATIN SMALL LETTER E WITH TILDE
+	0x1EBE: "\u00CA\u0301", // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND ACUTE
+	0x1EBF: "\u00EA\u0301", // LATIN SMALL LETTER E WITH CIRCUMFLEX AND ACUTE
+	0x1EC0: "\u00CA\u0300", // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND GRAVE
+	0x1EC1: "\u00EA\u0300", // LATIN SMALL LETTER E WITH CIRCUMFLEX AND GRAVE
+	0x1EC2: "\u00CA\u0309", // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EC3: "\u00EA\u0309", // LATIN SMALL LETTER E WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1EC4: "\u00CA\u0303", // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND TILDE
+	0x1EC5: "\u00EA\u0303", // LATIN SMALL LETTER E WITH CIRCUMFLEX AND TILDE
+	0x1EC6: "\u1EB8\u0302", // LATIN CAPITAL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+	0x1EC7: "\u1EB9\u0302", // LATIN SMALL LETTER E WITH CIRCUMFLEX AND DOT BELOW
+	0x1EC8: "I\u0309",      // LATIN CAPITAL LETTER I WITH HOOK ABOVE
+	0x1EC9: "i\u0309",      // LATIN SMALL LETTER I WITH HOOK ABOVE
+	0x1ECA: "I\u0323",      // LATIN CAPITAL LETTER I WITH DOT BELOW
+	0x1ECB: "i\u0323",      // LATIN SMALL LETTER I WITH DOT BELOW
+	0x1ECC: "O\u0323",      // LATIN CAPITAL LETTER O WITH DOT BELOW
+	0x1ECD: "o\u0323",      // LATIN SMALL LETTER O WITH DOT BELOW
+	0x1ECE: "O\u0309",      // LATIN CAPITAL LETTER O WITH HOOK ABOVE
+	0x1ECF: "o\u0309",      // LATIN SMALL LETTER O WITH HOOK ABOVE
+	0x1ED0: "\u00D4\u0301", // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND ACUTE
+	0x1ED1: "\u00F4\u0301", // LATIN SMALL LETTER O WITH CIRCUMFLEX AND ACUTE
+	0x1ED2: "\u00D4\u0300", // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND GRAVE
+	0x1ED3: "\u00F4\u0300", // LATIN SMALL LETTER O WITH CIRCUMFLEX AND GRAVE
+	0x1ED4: "\u00D4\u0309", // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1ED5: "\u00F4\u0309", // LATIN SMALL LETTER O WITH CIRCUMFLEX AND HOOK ABOVE
+	0x1ED6: "\u00D4\u0303", // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND TILDE
+	0x1ED7: "\u00F4\u0303", // LATIN SMALL LETTER O WITH CIRCUMFLEX AND TILDE
+	0x1ED8: "\u1ECC\u0302", // LATIN CAPITAL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+	0x1ED9: "\u1ECD\u0302", // LATIN SMALL LETTER O WITH CIRCUMFLEX AND DOT BELOW
+	0x1EDA: "\u01A0\u0301", // LATIN CAPITAL LETTER O WITH HORN AND ACUTE
+	0x1EDB: "\u01A1\u0301", // LATIN SMALL LETTER O WITH HORN AND ACUTE
+	0x1EDC: "\u01A0\u0300", // LATIN CAPITAL LETTER O WITH HORN AND GRAVE
+	0x1EDD: "\u01A1\u0300", // LATIN SMALL LETTER O WITH HORN AND GRAVE
+	0x1EDE: "\u01A0\u0309", // LATIN CAPITAL LETTER O WITH HORN AND HOOK ABOVE
+	0x1EDF: "\u01A1\u0309", // LATIN SMALL LETTER O WITH HORN AND HOOK ABOVE
+	0x1EE0: "\u01A0\u0303", // LATIN CAPITAL LETTER O WITH HORN AND TILDE
+	0x1EE1: "\u01A1\u0303", // LATIN SMALL LETTER O WITH HORN AND TILDE
+	0x1EE2: "\u01A0\u0323", // LATIN CAPITAL LETTER O WITH HORN AND DOT BELOW
+	0x1EE3: "\u01A1\u0323", // LATIN SMALL LETTER O WITH HORN AND DOT BELOW
+	0x1EE4: "U\u0323",      // LATIN CAPITAL LETTER U WITH DOT BELOW
+	0x1EE5: "u\u0323",      // LATIN SMALL LETTER U WITH DOT BELOW
+	0x1EE6: "U\u0309",      // LATIN CAPITAL LETTER U WITH HOOK ABOVE
+	0x1EE7: "u\u0309",      // LATIN SMALL LETTER U WITH HOOK ABOVE
+	0x1EE8: "\u01AF\u0301", // LATIN CAPITAL LETTER U WITH HORN AND ACUTE
+	0x1EE9: "\u01B0\u0301", // LATIN SMALL LETTER U WITH HORN AND ACUTE
+	0x1EEA: "\u01AF\u0300", // LATIN CAPITAL LETTER U WITH HORN AND GRAVE
+	0x1EEB: "\u01B0\u0300", // LATIN SMALL LETTER U WITH HORN AND GRAVE
+	0x1EEC: "\u01AF\u0309", // LATIN CAPITAL LETTER U WITH HORN AND HOOK ABOVE
+	0x1EED: "\u01B0\u0309", // LATIN SMALL LETTER U WITH HORN AND HOOK ABOVE
+	0x1EEE: "\u01AF\u0303", // LATIN CAPITAL LETTER U WITH HORN AND TILDE
+	0x1EEF: "\u01B0\u0303", // LATIN SMALL LETTER U WITH HORN AND TILDE
+	0x1EF0: "\u01AF\u0323", // LATIN CAPITAL LETTER U WITH HORN AND DOT BELOW
+	0x1EF1: "\u01B0\u0323", // LATIN SMALL LETTER U WITH HORN AND DOT BELOW
+	0x1EF2: "Y\u0300",      // LATIN CAPITAL LETTER Y WITH GRAVE
+	0x1EF3: "y\u0300",      // LATIN SMALL LETTER Y WITH GRAVE
+	0x1EF4: "Y\u0323",      // LATIN CAPITAL LETTER Y WITH DOT BELOW
+	0x1EF5: "y\u0323",      // LATIN SMALL LETTER Y WITH DOT BELOW
+	0x1EF6: "Y\u0309",      // LATIN CAPITAL LETTER Y WITH HOOK ABOVE
+	0x1EF7: "y\u0309",      // LATIN SMALL LETTER Y WITH HOOK ABOVE
+	0x1EF8: "Y\u0303",      // LATIN CAPITAL LETTER Y WITH TILDE
+	0x1EF9: "y\u0303",      // LATIN SMALL LETTER Y WITH TILDE
+	0x1F00: "\u03B1\u0313", // GREEK SMALL LETTER ALPHA WITH PSILI
+	0x1F01: "\u03B1\u0314", // GREEK SMALL LETTER ALPHA WITH DASIA
+	0x1F02: "\u1F00\u0300", // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA
+	0x1F03: "\u1F01\u0300", // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA
+	0x1F04: "\u1F00\u0301", // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA
+	0x1F05: "\u1F01\u0301", // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA
+	0x1F06: "\u1F00\u0342", // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI
+	0x1F07: "\u1F01\u0342", // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI
+	0x1F08: "\u0391\u0313", // GREEK CAPITAL LETTER ALPHA WITH PSILI
+	0x1F09: "\u0391\u0314", // GREEK CAPITAL LETTER ALPHA WITH DASIA
+	0x1F0A: "\u1F08\u0300", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA
+	0x1F0B: "\u1F09\u0300", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA
+	0x1F0C: "\u1F08\u0301", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA
+	0x1F0D: "\u1F09\u0301", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA
+	0x1F0E: "\u1F08\u0342", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI
+	0x1F0F: "\u1F09\u0342", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI
+	0x1F10: "\u03B5\u0313", // GREEK SMALL LETTER EPSILON WITH PSILI
+	0x1F11: "\u03B5\u0314", // GREEK SMALL LETTER EPSILON WITH DASIA
+	0x1F12: "\u1F10\u0300", // GREEK SMALL LETTER EPSILON WITH PSILI AND VARIA
+	0x1F13: "\u1F11\u0300", // GREEK SMALL LETTER EPSILON WITH DASIA AND VARIA
+	0x1F14: "\u1F10\u0301", // GREEK SMALL LETTER EPSILON WITH PSILI AND OXIA
+	0x1F15: "\u1F11\u0301", // GREEK SMALL LETTER EPSILON WITH DASIA AND OXIA
+	0x1F18: "\u0395\u0313", // GREEK CAPITAL LETTER EPSILON WITH PSILI
+	0x1F19: "\u0395\u0314", // GREEK CAPITAL LETTER EPSILON WITH DASIA
+	0x1F1A: "\u1F18\u0300", // GREEK CAPITAL LETTER EPSILON WITH PSILI AND VARIA
+	0x1F1B: "\u1F19\u0300", // GREEK CAPITAL LETTER EPSILON WITH DASIA AND VARIA
+	0x1F1C: "\u1F18\u0301", // GREEK CAPITAL LETTER EPSILON WITH PSILI AND OXIA
+	0x1F1D: "\u1F19\u0301", // GREEK CAPITAL LETTER EPSILON WITH DASIA AND OXIA
+	0x1F20: "\u03B7\u0313", // GREEK SMALL LETTER ETA WITH PSILI
+	0x1F21: "\u03B7\u0314", // GREEK SMALL LETTER ETA WITH DASIA
+	0x1F22: "\u1F20\u0300", // GREEK SMALL LETTER ETA WITH PSILI AND VARIA
+	0x1F23: "\u1F21\u0300", // GREEK SMALL LETTER ETA WITH DASIA AND VARIA
+	0x1F24: "\u1F20\u0301", // GREEK SMALL LETTER ETA WITH PSILI AND OXIA
+	0x1F25: "\u1F21\u0301", // GREEK SMALL LETTER ETA WITH DASIA AND OXIA
+	0x1F26: "\u1F20\u0342", // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI
+	0x1F27: "\u1F21\u0342", // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI
+	0x1F28: "\u0397\u0313", // GREEK CAPITAL LETTER ETA WITH PSILI
+	0x1F29: "\u0397\u0314", // GREEK CAPITAL LETTER ETA WITH DASIA
+	0x1F2A: "\u1F28\u0300", // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA
+	0x1F2B: "\u1F29\u0300", // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA
+	0x1F2C: "\u1F28\u0301", // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA
+	0x1F2D: "\u1F29\u0301", // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA
+	0x1F2E: "\u1F28\u0342", // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI
+	0x1F2F: "\u1F29\u0342", // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI
+	0x1F30: "\u03B9\u0313", // GREEK SMALL LETTER IOTA WITH PSILI
+	0x1F31: "\u03B9\u0314", // GREEK SMALL LETTER IOTA WITH DASIA
+	0x1F32: "\u1F30\u0300", // GREEK SMALL LETTER IOTA WITH PSILI AND VARIA
+	0x1F33: "\u1F31\u0300", // GREEK SMALL LETTER IOTA WITH DASIA AND VARIA
+	0x1F34: "\u1F30\u0301", // GREEK SMALL LETTER IOTA WITH PSILI AND OXIA
+	0x1F35: "\u1F31\u0301", // GREEK SMALL LETTER IOTA WITH DASIA AND OXIA
+	0x1F36: "\u1F30\u0342", // GREEK SMALL LETTER IOTA WITH PSILI AND PERISPOMENI
+	0x1F37: "\u1F31\u0342", // GREEK SMALL LETTER IOTA WITH DASIA AND PERISPOMENI
+	0x1F38: "\u0399\u0313", // GREEK CAPITAL LETTER IOTA WITH PSILI
+	0x1F39: "\u0399\u0314", // GREEK CAPITAL LETTER IOTA WITH DASIA
+	0x1F3A: "\u1F38\u0300", // GREEK CAPITAL LETTER IOTA WITH PSILI AND VARIA
+	0x1F3B: "\u1F39\u0300", // GREEK CAPITAL LETTER IOTA WITH DASIA AND VARIA
+	0x1F3C: "\u1F38\u0301", // GREEK CAPITAL LETTER IOTA WITH PSILI AND OXIA
+	0x1F3D: "\u1F39\u0301", // GREEK CAPITAL LETTER IOTA WITH DASIA AND OXIA
+	0x1F3E: "\u1F38\u0342", // GREEK CAPITAL LETTER IOTA WITH PSILI AND PERISPOMENI
+	0x1F3F: "\u1F39\u0342", // GREEK CAPITAL LETTER IOTA WITH DASIA AND PERISPOMENI
+	0x1F40: "\u03BF\u0313", // GREEK SMALL LETTER OMICRON WITH PSILI
+	0x1F41: "\u03BF\u0314", // GREEK SMALL LETTER OMICRON WITH DASIA
+	0x1F42: "\u1F40\u0300", // GREEK SMALL LETTER OMICRON WITH PSILI AND VARIA
+	0x1F43: "\u1F41\u0300", // GREEK SMALL LETTER OMICRON WITH DASIA AND VARIA
+	0x1F44: "\u1F40\u0301", // GREEK SMALL LETTER OMICRON WITH PSILI AND OXIA
+	0x1F45: "\u1F41\u0301", // GREEK SMALL LETTER OMICRON WITH DASIA AND OXIA
+	0x1F48: "\u039F\u0313", // GREEK CAPITAL LETTER OMICRON WITH PSILI
+	0x1F49: "\u039F\u0314", // GREEK CAPITAL LETTER OMICRON WITH DASIA
+	0x1F4A: "\u1F48\u0300", // GREEK CAPITAL LETTER OMICRON WITH PSILI AND VARIA
+	0x1F4B: "\u1F49\u0300", // GREEK CAPITAL LETTER OMICRON WITH DASIA AND VARIA
+	0x1F4C: "\u1F48\u0301", // GREEK CAPITAL LETTER OMICRON WITH PSILI AND OXIA
+	0x1F4D: "\u1F49\u0301", // GREEK CAPITAL LETTER OMICRON WITH DASIA AND OXIA
+	0x1F50: "\u03C5\u0313", // GREEK SMALL LETTER UPSILON WITH PSILI
+	0x1F51: "\u03C5\u0314", // GREEK SMALL LETTER UPSILON WITH DASIA
+	0x1F52: "\u1F50\u0300", // GREEK SMALL LETTER UPSILON WITH PSILI AND VARIA
+	0x1F53: "\u1F51\u0300", // GREEK SMALL LETTER UPSILON WITH DASIA AND VARIA
+	0x1F54: "\u1F50\u0301", // GREEK SMALL LETTER UPSILON WITH PSILI AND OXIA
+	0x1F55: "\u1F51\u0301", // GREEK SMALL LETTER UPSILON WITH DASIA AND OXIA
+	0x1F56: "\u1F50\u0342", // GREEK SMALL LETTER UPSILON WITH PSILI AND PERISPOMENI
+	0x1F57: "\u1F51\u0342", // GREEK SMALL LETTER UPSILON WITH DASIA AND PERISPOMENI
+	0x1F59: "\u03A5\u0314", // GREEK CAPITAL LETTER UPSILON WITH DASIA
+	0x1F5B: "\u1F59\u0300", // GREEK CAPITAL LETTER UPSILON WITH DASIA AND VARIA
+	0x1F5D: "\u1F59\u0301", // GREEK CAPITAL LETTER UPSILON WITH DASIA AND OXIA
+	0x1F5F: "\u1F59\u0342", // GREEK CAPITAL LETTER UPSILON WITH DASIA AND PERISPOMENI
+	0x1F60: "\u03C9\u0313", // GREEK SMALL LETTER OMEGA WITH PSILI
+	0x1F61: "\u03C9\u0314", // GREEK SMALL LETTER OMEGA WITH DASIA
+	0x1F62: "\u1F60\u0300", // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA
+	0x1F63: "\u1F61\u0300", // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA
+	0x1F64: "\u1F60\u0301", // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA
+	0x1F65: "\u1F61\u0301", // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA
+	0x1F66: "\u1F60\u0342", // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI
+	0x1F67: "\u1F61\u0342", // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI
+	0x1F68: "\u03A9\u0313", // GREEK CAPITAL LETTER OMEGA WITH PSILI
+	0x1F69: "\u03A9\u0314", // GREEK CAPITAL LETTER OMEGA WITH DASIA
+	0x1F6A: "\u1F68\u0300", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA
+	0x1F6B: "\u1F69\u0300", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA
+	0x1F6C: "\u1F68\u0301", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA
+	0x1F6D: "\u1F69\u0301", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA
+	0x1F6E: "\u1F68\u0342", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI
+	0x1F6F: "\u1F69\u0342", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI
+	0x1F70: "\u03B1\u0300", // GREEK SMALL LETTER ALPHA WITH VARIA
+	0x1F71: "\u03AC",       // GREEK SMALL LETTER ALPHA WITH OXIA
+	0x1F72: "\u03B5\u0300", // GREEK SMALL LETTER EPSILON WITH VARIA
+	0x1F73: "\u03AD",       // GREEK SMALL LETTER EPSILON WITH OXIA
+	0x1F74: "\u03B7\u0300", // GREEK SMALL LETTER ETA WITH VARIA
+	0x1F75: "\u03AE",       // GREEK SMALL LETTER ETA WITH OXIA
+	0x1F76: "\u03B9\u0300", // GREEK SMALL LETTER IOTA WITH VARIA
+	0x1F77: "\u03AF",       // GREEK SMALL LETTER IOTA WITH OXIA
+	0x1F78: "\u03BF\u0300", // GREEK SMALL LETTER OMICRON WITH VARIA
+	0x1F79: "\u03CC",       // GREEK SMALL LETTER OMICRON WITH OXIA
+	0x1F7A: "\u03C5\u0300", // GREEK SMALL LETTER UPSILON WITH VARIA
+	0x1F7B: "\u03CD",       // GREEK SMALL LETTER UPSILON WITH OXIA
+	0x1F7C: "\u03C9\u0300", // GREEK SMALL LETTER OMEGA WITH VARIA
+	0x1F7D: "\u03CE",       // GREEK SMALL LETTER OMEGA WITH OXIA
+	0x1F80: "\u1F00\u0345", // GREEK SMALL LETTER ALPHA WITH PSILI AND YPOGEGRAMMENI
+	0x1F81: "\u1F01\u0345", // GREEK SMALL LETTER ALPHA WITH DASIA AND YPOGEGRAMMENI
+	0x1F82: "\u1F02\u0345", // GREEK SMALL LETTER ALPHA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F83: "\u1F03\u0345", // GREEK SMALL LETTER ALPHA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F84: "\u1F04\u0345", // GREEK SMALL LETTER ALPHA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F85: "\u1F05\u0345", // GREEK SMALL LETTER ALPHA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F86: "\u1F06\u0345", // GREEK SMALL LETTER ALPHA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F87: "\u1F07\u0345", // GREEK SMALL LETTER ALPHA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F88: "\u1F08\u0345", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PROSGEGRAMMENI
+	0x1F89: "\u1F09\u0345", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PROSGEGRAMMENI
+	0x1F8A: "\u1F0A\u0345", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F8B: "\u1F0B\u0345", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F8C: "\u1F0C\u0345", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F8D: "\u1F0D\u0345", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F8E: "\u1F0E\u0345", // GREEK CAPITAL LETTER ALPHA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F8F: "\u1F0F\u0345", // GREEK CAPITAL LETTER ALPHA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F90: "\u1F20\u0345", // GREEK SMALL LETTER ETA WITH PSILI AND YPOGEGRAMMENI
+	0x1F91: "\u1F21\u0345", // GREEK SMALL LETTER ETA WITH DASIA AND YPOGEGRAMMENI
+	0x1F92: "\u1F22\u0345", // GREEK SMALL LETTER ETA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1F93: "\u1F23\u0345", // GREEK SMALL LETTER ETA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1F94: "\u1F24\u0345", // GREEK SMALL LETTER ETA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1F95: "\u1F25\u0345", // GREEK SMALL LETTER ETA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1F96: "\u1F26\u0345", // GREEK SMALL LETTER ETA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F97: "\u1F27\u0345", // GREEK SMALL LETTER ETA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1F98: "\u1F28\u0345", // GREEK CAPITAL LETTER ETA WITH PSILI AND PROSGEGRAMMENI
+	0x1F99: "\u1F29\u0345", // GREEK CAPITAL LETTER ETA WITH DASIA AND PROSGEGRAMMENI
+	0x1F9A: "\u1F2A\u0345", // GREEK CAPITAL LETTER ETA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1F9B: "\u1F2B\u0345", // GREEK CAPITAL LETTER ETA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1F9C: "\u1F2C\u0345", // GREEK CAPITAL LETTER ETA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1F9D: "\u1F2D\u0345", // GREEK CAPITAL LETTER ETA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1F9E: "\u1F2E\u0345", // GREEK CAPITAL LETTER ETA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1F9F: "\u1F2F\u0345", // GREEK CAPITAL LETTER ETA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FA0: "\u1F60\u0345", // GREEK SMALL LETTER OMEGA WITH PSILI AND YPOGEGRAMMENI
+	0x1FA1: "\u1F61\u0345", // GREEK SMALL LETTER OMEGA WITH DASIA AND YPOGEGRAMMENI
+	0x1FA2: "\u1F62\u0345", // GREEK SMALL LETTER OMEGA WITH PSILI AND VARIA AND YPOGEGRAMMENI
+	0x1FA3: "\u1F63\u0345", // GREEK SMALL LETTER OMEGA WITH DASIA AND VARIA AND YPOGEGRAMMENI
+	0x1FA4: "\u1F64\u0345", // GREEK SMALL LETTER OMEGA WITH PSILI AND OXIA AND YPOGEGRAMMENI
+	0x1FA5: "\u1F65\u0345", // GREEK SMALL LETTER OMEGA WITH DASIA AND OXIA AND YPOGEGRAMMENI
+	0x1FA6: "\u1F66\u0345", // GREEK SMALL LETTER OMEGA WITH PSILI AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA7: "\u1F67\u0345", // GREEK SMALL LETTER OMEGA WITH DASIA AND PERISPOMENI AND YPOGEGRAMMENI
+	0x1FA8: "\u1F68\u0345", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PROSGEGRAMMENI
+	0x1FA9: "\u1F69\u0345", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PROSGEGRAMMENI
+	0x1FAA: "\u1F6A\u0345", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND VARIA AND PROSGEGRAMMENI
+	0x1FAB: "\u1F6B\u0345", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND VARIA AND PROSGEGRAMMENI
+	0x1FAC: "\u1F6C\u0345", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND OXIA AND PROSGEGRAMMENI
+	0x1FAD: "\u1F6D\u0345", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND OXIA AND PROSGEGRAMMENI
+	0x1FAE: "\u1F6E\u0345", // GREEK CAPITAL LETTER OMEGA WITH PSILI AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FAF: "\u1F6F\u0345", // GREEK CAPITAL LETTER OMEGA WITH DASIA AND PERISPOMENI AND PROSGEGRAMMENI
+	0x1FB0: "\u03B1\u0306", // GREEK SMALL LETTER ALPHA WITH VRACHY
+	0x1FB1: "\u03B1\u0304", // GREEK SMALL LETTER ALPHA WITH MACRON
+	0x1FB2: "\u1F70\u0345", // GREEK SMALL LETTER ALPHA WITH VARIA AND YPOGEGRAMMENI
+	0x1FB3: "\u03B1\u0345", // GREEK SMALL LETTER ALPHA WITH YPOGEGRAMMENI
+	0x1FB4: "\u03AC\u0345", // GREEK SMALL LETTER ALPHA WITH OXIA AND YPOGEGRAMMENI
+	0x1FB6: "\u03B1\u0342", // GREEK SMALL LETTER ALPHA WITH PERISPOMENI
+	0x1FB7: "\u1FB6\u0345", // GREEK SMALL LETTER ALPHA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FB8: "\u0391\u0306", // GREEK CAPITAL LETTER ALPHA WITH VRACHY
+	0x1FB9: "\u0391\u0304", // GREEK CAPITAL LETTER ALPHA WITH MACRON
+	0x1FBA: "\u0391\u0300", // GREEK CAPITAL LETTER ALPHA WITH VARIA
+	0x1FBB: "\u0386",       // GREEK CAPITAL LETTER ALPHA WITH OXIA
+	0x1FBC: "\u0391\u0345", // GREEK CAPITAL LETTER ALPHA WITH PROSGEGRAMMENI
+	0x1FBE: "\u03B9",       // GREEK PROSGEGRAMMENI
+	0x1FC1: "\u00A8\u0342", // GREEK DIALYTIKA AND PERISPOMENI
+	0x1FC2: "\u1F74\u0345", // GREEK SMALL LETTER ETA WITH VARIA AND YPOGEGRAMMENI
+	0x1FC3: "\u03B7\u0345", // GREEK SMALL LETTER ETA WITH YPOGEGRAMMENI
+	0x1FC4: "\u03AE\u0345", // GREEK SMALL LETTER ETA WITH OXIA AND YPOGEGRAMMENI
+	0x1FC6: "\u03B7\u0342", // GREEK SMALL LETTER ETA WITH PERISPOMENI
+	0x1FC7: "\u1FC6\u0345", // GREEK SMALL LETTER ETA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FC8: "\u0395\u0300", // GREEK CAPITAL LETTER EPSILON WITH VARIA
+	0x1FC9: "\u0388",       // GREEK CAPITAL LETTER EPSILON WITH OXIA
+	0x1FCA: "\u0397\u0300", // GREEK CAPITAL LETTER ETA WITH VARIA
+	0x1FCB: "\u0389",       // GREEK CAPITAL LETTER ETA WITH OXIA
+	0x1FCC: "\u0397\u0345", // GREEK CAPITAL LETTER ETA WITH PROSGEGRAMMENI
+	0x1FCD: "\u1FBF\u0300", // GREEK PSILI AND VARIA
+	0x1FCE: "\u1FBF\u0301", // GREEK PSILI AND OXIA
+	0x1FCF: "\u1FBF\u0342", // GREEK PSILI AND PERISPOMENI
+	0x1FD0: "\u03B9\u0306", // GREEK SMALL LETTER IOTA WITH VRACHY
+	0x1FD1: "\u03B9\u0304", // GREEK SMALL LETTER IOTA WITH MACRON
+	0x1FD2: "\u03CA\u0300", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND VARIA
+	0x1FD3: "\u0390",       // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND OXIA
+	0x1FD6: "\u03B9\u0342", // GREEK SMALL LETTER IOTA WITH PERISPOMENI
+	0x1FD7: "\u03CA\u0342", // GREEK SMALL LETTER IOTA WITH DIALYTIKA AND PERISPOMENI
+	0x1FD8: "\u0399\u0306", // GREEK CAPITAL LETTER IOTA WITH VRACHY
+	0x1FD9: "\u0399\u0304", // GREEK CAPITAL LETTER IOTA WITH MACRON
+	0x1FDA: "\u0399\u0300", // GREEK CAPITAL LETTER IOTA WITH VARIA
+	0x1FDB: "\u038A",       // GREEK CAPITAL LETTER IOTA WITH OXIA
+	0x1FDD: "\u1FFE\u0300", // GREEK DASIA AND VARIA
+	0x1FDE: "\u1FFE\u0301", // GREEK DASIA AND OXIA
+	0x1FDF: "\u1FFE\u0342", // GREEK DASIA AND PERISPOMENI
+	0x1FE0: "\u03C5\u0306", // GREEK SMALL LETTER UPSILON WITH VRACHY
+	0x1FE1: "\u03C5\u0304", // GREEK SMALL LETTER UPSILON WITH MACRON
+	0x1FE2: "\u03CB\u0300", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND VARIA
+	0x1FE3: "\u03B0",       // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND OXIA
+	0x1FE4: "\u03C1\u0313", // GREEK SMALL LETTER RHO WITH PSILI
+	0x1FE5: "\u03C1\u0314", // GREEK SMALL LETTER RHO WITH DASIA
+	0x1FE6: "\u03C5\u0342", // GREEK SMALL LETTER UPSILON WITH PERISPOMENI
+	0x1FE7: "\u03CB\u0342", // GREEK SMALL LETTER UPSILON WITH DIALYTIKA AND PERISPOMENI
+	0x1FE8: "\u03A5\u0306", // GREEK CAPITAL LETTER UPSILON WITH VRACHY
+	0x1FE9: "\u03A5\u0304", // GREEK CAPITAL LETTER UPSILON WITH MACRON
+	0x1FEA: "\u03A5\u0300", // GREEK CAPITAL LETTER UPSILON WITH VARIA
+	0x1FEB: "\u038E",       // GREEK CAPITAL LETTER UPSILON WITH OXIA
+	0x1FEC: "\u03A1\u0314", // GREEK CAPITAL LETTER RHO WITH DASIA
+	0x1FED: "\u00A8\u0300", // GREEK DIALYTIKA AND VARIA
+	0x1FEE: "\u0385",       // GREEK DIALYTIKA AND OXIA
+	0x1FEF: "`",            // GREEK VARIA
+	0x1FF2: "\u1F7C\u0345", // GREEK SMALL LETTER OMEGA WITH VARIA AND YPOGEGRAMMENI
+	0x1FF3: "\u03C9\u0345", // GREEK SMALL LETTER OMEGA WITH YPOGEGRAMMENI
+	0x1FF4: "\u03CE\u0345", // GREEK SMALL LETTER OMEGA WITH OXIA AND YPOGEGRAMMENI
+	0x1FF6: "\u03C9\u0342", // GREEK SMALL LETTER OMEGA WITH PERISPOMENI
+	0x1FF7: "\u1FF6\u0345", // GREEK SMALL LETTER OMEGA WITH PERISPOMENI AND YPOGEGRAMMENI
+	0x1FF8: "\u039F\u0300", // GREEK CAPITAL LETTER OMICRON WITH VARIA
+	0x1FF9: "\u038C",       // GREEK CAPITAL LETTER OMICRON WITH OXIA
+	0x1FFA: "\u03A9\u0300", // GREEK CAPITAL LETTER OMEGA WITH VARIA
+	0x1FFB: "\u038F",       // GREEK CAPITAL LETTER OMEGA WITH OXIA
+	0x1FFC: "\u03A9\u0345", // GREEK CAPITAL LETTER OMEGA WITH PROSGEGRAMMENI
+	0x1FFD: "\u00B4",       // GREEK OXIA
+	0x2000: "\u2002",       // EN QUAD
+	0x2001: "\u2003",       // EM QUAD
+	0x2126: "\u03A9",       // OHM SIGN
+	0x212A: "K",            // KELVIN SIGN
+	0x212B: "\u00C5",       // ANGSTROM SIGN
+	0x219A: "\u2190\u0338", // LEFTWARDS ARROW WITH STROKE
+	0x219B: "\u2192\u0338", // RIGHTWARDS ARROW WITH STROKE
+	0x21AE: "\u2194\u0338", // LEFT RIGHT ARROW WITH STROKE
+	0x21CD: "\u21D0\u0338", // LEFTWARDS DOUBLE ARROW WITH STROKE
+	0x21CE: "\u21D4\u0338", // LEFT RIGHT DOUBLE ARROW WITH STROKE
+	0x21CF: "\u21D2\u0338", // RIGHTWARDS DOUBLE ARROW WITH STROKE
+	0x2204: "\u2203\u0338", // THERE DOES NOT EXIST
+	0x2209: "\u2208\u0338", // NOT AN ELEMENT OF
+	0x220C: "\u220B\u0338", // DOES NOT CONTAIN AS MEMBER
+	0x2224: "\u2223\u0338", // DOES NOT DIVIDE
+	0x2226: "\u2225\u0338", // NOT PARALLEL TO
+	0x2241: "\u223C\u0338", // NOT TILDE
+	0x2244: "\u2243\u0338", // NOT ASYMPTOTICALLY EQUAL TO
+	0x2247: "\u2245\u0338", // NEITHER APPROXIMATELY NOR ACTUALLY EQUAL TO
+	0x2249: "\u2248\u0338", // NOT ALMOST EQUAL TO
+	0x2260: "=\u0338",      // NOT EQUAL TO
+	0x2262: "\u2261\u0338", // NOT IDENTICAL TO
+	0x226D: "\u224D\u0338", // NOT EQUIVALENT TO
+	0x226E: "<\u0338",      // NOT LESS-THAN
+	0x226F: ">\u0338",      // NOT GREATER-THAN
+	0x2270: "\u2264\u0338", // NEITHER LESS-THAN NOR EQUAL TO
+	0x2271: "\u2265\u0338", // NEITHER GREATER-THAN NOR EQUAL TO
+	0x2274: "\u2272\u0338", // NEITHER LESS-THAN NOR EQUIVALENT TO
+	0x2275: "\u2273\u0338", // NEITHER GREATER-THAN NOR EQUIVALENT TO
+	0x2278: "\u2276\u0338", // NEITHER LESS-THAN NOR GREATER-THAN
+	0x2279: "\u2277\u0338", // NEITHER GREATER-THAN NOR LESS-THAN
+	0x2280: "\u227A\u0338", // DOES NOT PRECEDE
+	0x2281: "\u227B\u0338", // DOES NOT SUCCEED
+	0x2284: "\u2282\u0338", // NOT A SUBSET OF
+	0x2285: "\u2283\u0338", // NOT A SUPERSET OF
+	0x2288: "\u2286\u0338", // NEITHER A SUBSET OF NOR EQUAL TO
+	0x2289: "\u2287\u0338", // NEITHER A SUPERSET OF NOR EQUAL TO
+	0x22AC: "\u22A2\u0338", // DOES NOT PROVE
+	0x22AD: "\u22A8\u0338", // NOT TRUE
+	0x22AE: "\u22A9\u0338", // DOES NOT FORCE
+	0x22AF: "\u22AB\u0338", // NEGATED DOUBLE VERTICAL BAR DOUBLE RIGHT TURNSTILE
+	0x22E0: "\u227C\u0338", // DOES NOT PRECEDE OR EQUAL
+	0x22E1: "\u227D\u0338", // DOES NOT SUCCEED OR EQUAL
+	0x22E2: "\u2291\u0338", // NOT SQUARE IMAGE OF OR EQUAL TO
+	0x22E3: "\u2292\u0338", // NOT SQUARE ORIGINAL OF OR EQUAL TO
+	0x22EA: "\u22B2\u0338", // NOT NORMAL SUBGROUP OF
+	0x22EB: "\u22B3\u0338", // DOES NOT CONTAIN AS NORMAL SUBGROUP
+	0x22EC: "\u22B4\u0338", // NOT NORMAL SUBGROUP OF OR EQUAL TO
+	0x22ED: "\u22B5\u0338", // DOES NOT CONTAIN AS NORMAL SUBGROUP OR EQUAL
+	0x2329: "\u3008",       // LEFT-POINTING ANGLE BRACKET
+	0x232A: "\u3009",       // RIGHT-POINTING ANGLE BRACKET
+	0x2ADC: "\u2ADD\u0338", // FORKING
+	0xFB1D: "\u05D9\u05B4", // HEBREW LETTER YOD WITH HIRIQ
+	0xFB1F: "\u05F2\u05B7", // HEBREW LIGATURE YIDDISH YOD YOD PATAH
+	0xFB2A: "\u05E9\u05C1", // HEBREW LETTER SHIN WITH SHIN DOT
+	0xFB2B: "\u05E9\u05C2", // HEBREW LETTER SHIN WITH SIN DOT
+	0xFB2C: "\uFB49\u05C1", // HEBREW LETTER SHIN WITH DAGESH AND SHIN DOT
+	0xFB2D: "\uFB49\u05C2", // HEBREW LETTER SHIN WITH DAGESH AND SIN DOT
+	0xFB2E: "\u05D0\u05B7", // HEBREW LETTER ALEF WITH PATAH
+	0xFB2F: "\u05D0\u05B8", // HEBREW LETTER ALEF WITH QAMATS
+	0xFB30: "\u05D0\u05BC", // HEBREW LETTER ALEF WITH MAPIQ
+	0xFB31: "\u05D1\u05BC", // HEBREW LETTER BET WITH DAGESH
+	0xFB32: "\u05D2\u05BC", // HEBREW LETTER GIMEL WITH DAGESH
+	0xFB33: "\u05D3\u05BC", // HEBREW LETTER DALET WITH DAGESH
+	0xFB34: "\u05D4\u05BC", // HEBREW LETTER HE WITH MAPIQ
+	0xFB35: "\u05D5\u05BC", // HEBREW LETTER VAV WITH DAGESH
+	0xFB36: "\u05D6\u05BC", // HEBREW LETTER ZAYIN WITH DAGESH
+	0xFB38: "\u05D8\u05BC", // HEBREW LETTER TET WITH DAGESH
+	0xFB39: "\u05D9\u05BC", // HEBREW LETTER YOD WITH DAGESH
+	0xFB3A: "\u05DA\u05BC", // HEBREW LETTER FINAL KAF WITH DAGESH
+	0xFB3B: "\u05DB\u05BC", // HEBREW LETTER KAF WITH DAGESH
+	0xFB3C: "\u05DC\u05BC", // HEBREW LETTER LAMED WITH DAGESH
+	0xFB3E: "\u05DE\u05BC", // HEBREW LETTER MEM WITH DAGESH
+	0xFB40: "\u05E0\u05BC", // HEBREW LETTER NUN WITH DAGESH
+	0xFB41: "\u05E1\u05BC", // HEBREW LETTER SAMEKH WITH DAGESH
+	0xFB43: "\u05E3\u05BC", // HEBREW LETTER FINAL PE WITH DAGESH
+	0xFB44: "\u05E4\u05BC", // HEBREW LETTER PE WITH DAGESH
+	0xFB46: "\u05E6\u05BC", // HEBREW LETTER TSADI WITH DAGESH
+	0xFB47: "\u05E7\u05BC", // HEBREW LETTER QOF WITH DAGESH
+	0xFB48: "\u05E8\u05BC", // HEBREW LETTER RESH WITH DAGESH
+	0xFB49: "\u05E9\u05BC", // HEBREW LETTER SHIN WITH DAGESH
+	0xFB4A: "\u05EA\u05BC", // HEBREW LETTER TAV WITH DAGESH
+	0xFB4B: "\u05D5\u05B9", // HEBREW LETTER VAV WITH HOLAM
+	0xFB4C: "\u05D1\u05BF", // HEBREW LETTER BET WITH RAFE
+	0xFB4D: "\u05DB\u05BF", // HEBREW LETTER KAF WITH RAFE
+	0xFB4E: "\u05E4\u05BF", // HEBREW LETTER PE WITH RAFE
+}
@@ -0,0 +1,283 @@
+package marc
+
+// Character sets used by MARC-8. Each set is identified by the final
+// character of the escape sequence used to designate it.
+// See https://www.loc.gov/marc/specifications/speccharmarc8.html
+const (
+	charsetBasicLatin       = 'B' // ASCII
+	charsetExtendedLatin    = 'E' // ANSEL
+	charsetGreekSymbols     = 'g'
+	charsetSubscripts       = 'b'
+	charsetSuperscripts     = 'p'
+	charsetBasicHebrew      = '2'
+	charsetBasicArabic      = '3'
+	charsetExtendedArabic   = '4'
+	charsetBasicCyrillic    = 'N'
+	charsetExtendedCyrillic = 'Q'
+	charsetBasicGreek       = 'S'
+	charsetCJK              = '1' // East Asian Character Code (EACC), multibyte
+)
+
+// marc8Char is the Unicode equivalent of a MARC-8 character. Combining
+// characters are stored in MARC-8 *before* the character they modify
+// whereas in Unicode they go after it.
+type marc8Char struct {
+	r         rune
+	combining bool
+}
+
+// marc8Sets maps the single byte character sets to their Unicode values.
+// Keys are the 7-bit value of the character (0x21-0x7E) which is how
+// the character is encoded when the set is designated as G0, when the
+// set is designated as G1 the high bit is set (0xA1-0xFE).
+var marc8Sets = map[byte]map[byte]marc8Char{
+	charsetExtendedLatin: {
+		0x21: {0x0141, false}, // LATIN CAPITAL LETTER L WITH STROKE
+		0x22: {0x00D8, false}, // LATIN CAPITAL LETTER O WITH STROKE
+		0x23: {0x0110, false}, // LATIN CAPITAL LETTER D WITH STROKE
+		0x24: {0x00DE, false}, // LATIN CAPITAL LETTER THORN
+		0x25: {0x00C6, false}, // LATIN CAPITAL LETTER AE
+		0x26: {0x0152, false}, // LATIN CAPITAL LIGATURE OE
+		0x27: {0x02B9, false}, // MODIFIER LETTER PRIME
+		0x28: {0x00B7, false}, // MIDDLE DOT
+		0x29: {0x266D, false}, // MUSIC FLAT SIGN
+		0x2A: {0x00AE, false}, // REGISTERED SIGN
+		0x2B: {0x00B1, false}, // PLUS-MINUS SIGN
+		0x2C: {0x01A0, false}, // LATIN CAPITAL LETTER O WITH HORN
+		0x2D: {0x01AF, false}, // LATIN CAPITAL LETTER U WITH HORN
+		0x2E: {0x02BC, false}, // MODIFIER LETTER APOSTROPHE
+		0x30: {0x02BB, false}, // MODIFIER LETTER TURNED COMMA
+		0x31: {0x0142, false}, // LATIN SMALL LETTER L WITH STROKE
+		0x32: {0x00F8, false}, // LATIN SMALL LETTER O WITH STROKE
+		0x33: {0x0111, false}, // LATIN SMALL LETTER D WITH STROKE
+		0x34: {0x00FE, false}, // LATIN SMALL LETTER THORN
+		0x35: {0x00E6, false}, // LATIN SMALL LETTER AE
+		0x36: {0x0153, false}, // LATIN SMALL LIGATURE OE
+		0x37: {0x02BA, false}, // MODIFIER LETTER DOUBLE PRIME
+		0x38: {0x0131, false}, // LATIN SMALL LETTER DOTLESS I
+		0x39: {0x00A3, false}, // POUND SIGN
+		0x3A: {0x00F0, false}, // LATIN SMALL LETTER ETH
+		0x3C: {0x01A1, false}, // LATIN SMALL LETTER O WITH HORN
+		0x3D: {0x01B0, false}, // LATIN SMALL LETTER U WITH HORN
+		0x40: {0x00B0, false}, // DEGREE SIGN
+		0x41: {0x2113, false}, // SCRIPT SMALL L
+		0x42: {0x2117, false}, // SOUND RECORDING COPYRIGHT
+		0x43: {0x00A9, false}, // COPYRIGHT SIGN
+		0x44: {0x266F, false}, // MUSIC SHARP SIGN
+		0x45: {0x00BF, false}, // INVERTED QUESTION MARK
+		0x46: {0x00A1, false}, // INVERTED EXCLAMATION MARK
+		0x47: {0x00DF, false}, // LATIN SMALL LETTER SHARP S
+		0x48: {0x20AC, false}, // EURO SIGN
+		0x60: {0x0309, true},  // COMBINING HOOK ABOVE
+		0x61: {0x0300, true},  // COMBINING GRAVE ACCENT
+		0x62: {0x0301, true},  // COMBINING ACUTE ACCENT
+		0x63: {0x0302, true},  // COMBINING CIRCUMFLEX ACCENT
+		0x64: {0x0303, true},  // COMBINING TILDE
+		0x65: {0x0304, true},  // COMBINING MACRON
+		0x66: {0x0306, true},  // COMBINING BREVE
+		0x67: {0x0307, true},  // COMBINING DOT ABOVE
+		0x68: {0x0308, true},  // COMBINING DIAERESIS
+		0x69: {0x030C, true},  // COMBINING CARON
+		0x6A: {0x030A, true},  // COMBINING RING ABOVE
+		0x6B: {0xFE20, true},  // COMBINING LIGATURE LEFT HALF
+		0x6C: {0xFE21, true},  // COMBINING LIGATURE RIGHT HALF
+		0x6D: {0x0315, true},  // COMBINING COMMA ABOVE RIGHT
+		0x6E: {0x030B, true},  // COMBINING DOUBLE ACUTE ACCENT
+		0x6F: {0x0310, true},  // COMBINING CANDRABINDU
+		0x70: {0x0327, true},  // COMBINING CEDILLA
+		0x71: {0x0328, true},  // COMBINING OGONEK
+		0x72: {0x0323, true},  // COMBINING DOT BELOW
+		0x73: {0x0324, true},  // COMBINING DIAERESIS BELOW
+		0x74: {0x0325, true},  // COMBINING RING BELOW
+		0x75: {0x0333, true},  // COMBINING DOUBLE LOW LINE
+		0x76: {0x0332, true},  // COMBINING LOW LINE
+		0x77: {0x0326, true},  // COMBINING COMMA BELOW
+		0x78: {0x031C, true},  // COMBINING LEFT HALF RING BELOW
+		0x79: {0x032E, true},  // COMBINING BREVE BELOW
+		0x7A: {0xFE22, true},  // COMBINING DOUBLE TILDE LEFT HALF
+		0x7B: {0xFE23, true},  // COMBINING DOUBLE TILDE RIGHT HALF
+		0x7E: {0x0313, true},  // COMBINING COMMA ABOVE
+	},
+	charsetGreekSymbols: {
+		0x61: {0x03B1, false}, // GREEK SMALL LETTER ALPHA
+		0x62: {0x03B2, false}, // GREEK SMALL LETTER BETA
+		0x63: {0x03B3, false}, // GREEK SMALL LETTER GAMMA
+	},
+	charsetSubscripts: {
+		0x28: {0x208D, false}, // SUBSCRIPT LEFT PARENTHESIS
+		0x29: {0x208E, false}, // SUBSCRIPT RIGHT PARENTHESIS
+		0x2B: {0x208A, false}, // SUBSCRIPT PLUS SIGN
+		0x2D: {0x208B, false}, // SUBSCRIPT MINUS
+		0x30: {0x2080, false}, // SUBSCRIPT ZERO
+		0x31: {0x2081, false},
+		0x32: {0x2082, false},
+		0x33: {0x2083, false},
+		0x34: {0x2084, false},
+		0x35: {0x2085, false},
+		0x36: {0x2086, false},
+		0x37: {0x2087, false},
+		0x38: {0x2088, false},
+		0x39: {0x2089, false}, // SUBSCRIPT NINE
+	},
+	charsetSuperscripts: {
+		0x28: {0x207D, false}, // SUPERSCRIPT LEFT PARENTHESIS
+		0x29: {0x207E, false}, // SUPERSCRIPT RIGHT PARENTHESIS
+		0x2B: {0x207A, false}, // SUPERSCRIPT PLUS SIGN
+		0x2D: {0x207B, false}, // SUPERSCRIPT MINUS
+		0x30: {0x2070, false}, // SUPERSCRIPT ZERO
+		0x31: {0x00B9, false}, // SUPERSCRIPT ONE
+		0x32: {0x00B2, false}, // SUPERSCRIPT TWO
+		0x33: {0x00B3, false}, // SUPERSCRIPT THREE
+		0x34: {0x2074, false},
+		0x35: {0x2075, false},
+		0x36: {0x2076, false},
+		0x37: {0x2077, false},
+		0x38: {0x2078, false},
+		0x39: {0x2079, false}, // SUPERSCRIPT NINE
+	},
+	charsetBasicCyrillic:    basicCyrillic(),
+	charsetExtendedCyrillic: extendedCyrillic(),
+	charsetBasicHebrew:      basicHebrew(),
+	charsetBasicArabic:      basicArabic(),
+	charsetBasicGreek:       basicGreek(),
+}
+
+// marc8CJK maps the East Asian Character Code (EACC) characters, which
+// use three bytes each, to their Unicode values. Notice that this table
+// only includes the Japanese kana. Records with other EACC characters (or
+// with characters from the Extended Arabic set, which has no table) cannot
+// be converted to UTF-8 and are left in MARC-8, see ErrUnmappedMarc8.
+var marc8CJK = eaccKana()
+
+// asciiPunctuation adds to the set the characters in positions 0x21-0x3F
+// which most non-Latin MARC-8 sets share with ASCII (digits and punctuation).
+func asciiPunctuation(set map[byte]marc8Char) map[byte]marc8Char {
+	for c := byte(0x21); c <= 0x3F; c++ {
+		set[c] = marc8Char{rune(c), false}
+	}
+	return set
+}
+
+func basicCyrillic() map[byte]marc8Char {
+	set := asciiPunctuation(map[byte]marc8Char{})
+	// ISO 5427 (KOI-7 ordering), lowercase in 0x40-0x5F and
+	// uppercase in 0x60-0x7E.
+	lower := []rune("юабцдефгхийклмнопярстужвьызшэщчъ")
+	upper := []rune("ЮАБЦДЕФГХИЙКЛМНОПЯРСТУЖВЬЫЗШЭЩЧ")
+	for i, r := range lower {
+		set[byte(0x40+i)] = marc8Char{r, false}
+	}
+	for i, r := range upper {
+		set[byte(0x60+i)] = marc8Char{r, false}
+	}
+	return set
+}
+
+func extendedCyrillic() map[byte]marc8Char {
+	set := map[byte]marc8Char{}
+	lower := []rune("ґђѓєёѕіїјљњћќўџ")
+	upper := []rune("ҐЂЃЄЁЅІЇЈЉЊЋЌЎЏ")
+	for i, r := range lower {
+		set[byte(0x40+i)] = marc8Char{r, false}
+	}
+	for i, r := range upper {
+		set[byte(0x60+i)] = marc8Char{r, false}
+	}
+	set[0x50] = marc8Char{0x0463, false} // CYRILLIC SMALL LETTER YAT
+	set[0x51] = marc8Char{0x0473, false} // CYRILLIC SMALL LETTER FITA
+	set[0x52] = marc8Char{0x0475, false} // CYRILLIC SMALL LETTER IZHITSA
+	set[0x53] = marc8Char{0x046B, false} // CYRILLIC SMALL LETTER BIG YUS
+	set[0x70] = marc8Char{0x0462, false} // CYRILLIC CAPITAL LETTER YAT
+	set[0x71] = marc8Char{0x0472, false} // CYRILLIC CAPITAL LETTER FITA
+	set[0x72] = marc8Char{0x0474, false} // CYRILLIC CAPITAL LETTER IZHITSA
+	set[0x73] = marc8Char{0x046A, false} // CYRILLIC CAPITAL LETTER BIG YUS
+	return set
+}
+
+func basicHebrew() map[byte]marc8Char {
+	set := asciiPunctuation(map[byte]marc8Char{})
+	// Alef (U+05D0) through Tav (U+05EA), including the final forms.
+	for c := byte(0x60); c <= 0x7A; c++ {
+		set[c] = marc8Char{rune(0x05D0 + int(c-0x60)), false}
+	}
+	return set
+}
+
+func basicArabic() map[byte]marc8Char {
+	set := asciiPunctuation(map[byte]marc8Char{})
+	// Arabic-Indic digits and punctuation
+	for c := byte(0x30); c <= 0x39; c++ {
+		set[c] = marc8Char{rune(0x0660 + int(c-0x30)), false}
+	}
+	set[0x2C] = marc8Char{0x060C, false} // ARABIC COMMA
+	set[0x3B] = marc8Char{0x061B, false} // ARABIC SEMICOLON
+	set[0x3F] = marc8Char{0x061F, false} // ARABIC QUESTION MARK
+	// Hamza (U+0621) through Ghain (U+063A)
+	for c := byte(0x41); c <= 0x5A; c++ {
+		set[c] = marc8Char{rune(0x0621 + int(c-0x41)), false}
+	}
+	// Tatweel (U+0640) through Yeh (U+064A)
+	for c := byte(0x60); c <= 0x6A; c++ {
+		set[c] = marc8Char{rune(0x0640 + int(c-0x60)), false}
+	}
+	// Fathatan (U+064B) through Sukun (U+0652)
+	for c := byte(0x6B); c <= 0x72; c++ {
+		set[c] = marc8Char{rune(0x064B + int(c-0x6B)), true}
+	}
+	return set
+}
+
+func basicGreek() map[byte]marc8Char {
+	set := map[byte]marc8Char{
+		0x21: {0x0300, true},  // COMBINING GRAVE ACCENT
+		0x22: {0x0301, true},  // COMBINING ACUTE ACCENT
+		0x23: {0x0308, true},  // COMBINING DIAERESIS
+		0x24: {0x0342, true},  // COMBINING GREEK PERISPOMENI
+		0x25: {0x0313, true},  // COMBINING COMMA ABOVE (SMOOTH BREATHING)
+		0x26: {0x0314, true},  // COMBINING REVERSED COMMA ABOVE (ROUGH BREATHING)
+		0x27: {0x0345, true},  // COMBINING GREEK YPOGEGRAMMENI
+		0x28: {'(', false},    // LEFT PARENTHESIS
+		0x29: {')', false},    // RIGHT PARENTHESIS
+		0x2C: {',', false},    // COMMA
+		0x2D: {'-', false},    // HYPHEN-MINUS
+		0x2E: {'.', false},    // FULL STOP
+		0x2F: {'/', false},    // SOLIDUS
+		0x30: {0x00AB, false}, // LEFT-POINTING DOUBLE ANGLE QUOTATION MARK
+		0x31: {0x00BB, false}, // RIGHT-POINTING DOUBLE ANGLE QUOTATION MARK
+		0x32: {0x201C, false}, // LEFT DOUBLE QUOTATION MARK
+		0x33: {0x201D, false}, // RIGHT DOUBLE QUOTATION MARK
+		0x34: {0x0374, false}, // GREEK NUMERAL SIGN
+		0x35: {0x0375, false}, // GREEK LOWER NUMERAL SIGN
+		0x3A: {':', false},    // COLON
+		0x3B: {0x0387, false}, // GREEK ANO TELEIA
+		0x3F: {0x037E, false}, // GREEK QUESTION MARK
+	}
+	// Uppercase in 0x41-0x5E and lowercase in 0x61-0x7E, including the
+	// archaic letters (stigma, digamma, koppa, sampi), beta with curl and
+	// final sigma in the lowercase letters. Positions 0x43 and 0x57 are
+	// not used.
+	upper := []rune("ΑΒ\x00ΓΔΕϚϜΖΗΘΙΚΛΜΝΞΟΠϞΡΣ\x00ΤΥΦΧΨΩϠ")
+	lower := []rune("αβϐγδεϛϝζηθικλμνξοπϟρσςτυφχψωϡ")
+	for i, r := range upper {
+		if r != 0 {
+			set[byte(0x41+i)] = marc8Char{r, false}
+		}
+	}
+	for i, r := range lower {
+		set[byte(0x61+i)] = marc8Char{r, false}
+	}
+	return set
+}
+
+// eaccKana follows JIS X 0208 rows 0x24 (Hiragana) and 0x25 (Katakana)
+// which EACC uses under the 0x69 prefix.
+func eaccKana() map[int]marc8Char {
+	set := map[int]marc8Char{}
+	for c := 0x21; c <= 0x73; c++ {
+		set[0x692400+c] = marc8Char{rune(0x3041 + c - 0x21), false}
+	}
+	for c := 0x21; c <= 0x76; c++ {
+		set[0x692500+c] = marc8Char{rune(0x30A1 + c - 0x21), false}
+	}
+	return set
+}
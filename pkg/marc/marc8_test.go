@@ -0,0 +1,266 @@
+package marc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDecodeMarc8(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "ASCII", input: "Coal sampling.", want: "Coal sampling."},
+		{name: "ANSEL spacing characters", input: "\xa5sop \xb1odz \xc3 1990", want: "Æsop łodz © 1990"},
+		{name: "combining diacritic before base letter", input: "Caf\xe2e", want: "Cafe\u0301"},
+		{name: "two combining diacritics", input: "\xe2\xf2a", want: "a\u0301\u0323"},
+		{name: "subfield delimiters pass through", input: "\x1faM\xe8uller", want: "\x1faMu\u0308ller"},
+		{name: "trailing combining diacritic", input: "\x1faCaf\xe2\x1fbx", want: "\x1faCaf\u0301\x1fbx"},
+		{name: "Cyrillic G0", input: "\x1b(NAB\x1b(B x", want: "аб x"},
+		{name: "Cyrillic G1", input: "\x1b)N\xe1\xc2", want: "Аб"},
+		{name: "subscript", input: "H\x1bb2\x1bsO", want: "H₂O"},
+		{name: "superscript", input: "m\x1bp2\x1bs", want: "m²"},
+		{name: "Greek symbols", input: "\x1bga\x1bs-rays", want: "α-rays"},
+		{name: "Hebrew", input: "\x1b(2`a", want: "אב"},
+		{name: "CJK kana", input: "\x1b$1\x69\x24\x22\x1b(B!", want: "あ!"},
+		{name: "unknown CJK", input: "\x1b$1\x21\x21\x21", want: "�"},
+		{name: "Basic Greek", input: "\x1b(SN\x22rdrw\x1b(B 1", want: "Λο\u0301γος 1"},
+		{name: "numeric character references", input: "&#x590F;&#x76EE; &#x6F31;&#X77F3;", want: "夏目 漱石"},
+		{name: "not a numeric character reference", input: "AT&T &#x; &#xZZ; &#x110000;", want: "AT&T &#x; &#xZZ; &#x110000;"},
+		{name: "unknown ANSEL", input: "\xaf", want: "�"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DecodeMarc8([]byte(tt.input))
+			if got != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestConvertMarc8(t *testing.T) {
+	t.Parallel()
+
+	data, err := ioutil.ReadFile("testdata/test_1a.mrc")
+	if err != nil {
+		t.Fatalf("error reading file: %v", err)
+	}
+	// Flag the record as MARC-8
+	data[9] = ' '
+
+	f := NewMarcFile(bytes.NewBuffer(data))
+	f.ConvertMarc8(true)
+	f.Scan()
+	r, err := f.Record()
+	if err != nil {
+		t.Fatalf("problem calling Record on MarcFile: %s", err)
+	}
+
+	if r.Leader.CharCoding != 'a' {
+		t.Errorf("expected leader/09 to be 'a', got %q", r.Leader.CharCoding)
+	}
+	if r.Leader.Raw() != "01805nam a2200385 i 4500" {
+		t.Errorf("unexpected leader %q", r.Leader.Raw())
+	}
+	// The binary data must be rebuilt to include the conversion
+	if !r.Modified() {
+		t.Errorf("expected converted record to be modified")
+	}
+	if data, _ := r.MarshalBinary(); data[9] != 'a' {
+		t.Errorf("expected leader/09 to be 'a' in the binary data, got %q", data[9])
+	}
+	want := setUpTestRecord("testdata/test_1a.mrc", t)
+	if len(r.Fields) != len(want.Fields) {
+		t.Errorf("expected %d fields, got %d", len(want.Fields), len(r.Fields))
+	}
+}
+
+func TestConvertMarc8Han(t *testing.T) {
+	t.Parallel()
+
+	// Write the record with Han characters in MARC-8 and read it back
+	want := setUpTestRecord("testdata/test_880.mrc", t)
+	e := NewMarc8Encoder(true)
+	encoded, err := e.EncodeRecord(want)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f := NewMarcFile(bytes.NewBuffer(encoded.Raw()))
+	f.ConvertMarc8(true)
+	f.Scan()
+	got, err := f.Record()
+	if err != nil {
+		t.Fatalf("problem calling Record on MarcFile: %s", err)
+	}
+	if got.Leader.CharCoding != 'a' {
+		t.Errorf("expected leader/09 to be 'a', got %q", got.Leader.CharCoding)
+	}
+	// The Latin fields come back decomposed (e.g. o + U+0304), the 880
+	// fields must be unchanged.
+	if diff := cmp.Diff(want.FieldsByTag("880"), got.FieldsByTag("880")); diff != "" {
+		t.Errorf("880 fields mismatch (-want +got):\n%s", diff)
+	}
+	if value := got.GetValue("880", "a"); value != "夏目漱石," {
+		t.Errorf("expected %q, got %q", "夏目漱石,", value)
+	}
+}
+
+func TestConvertMarc8Unmapped(t *testing.T) {
+	t.Parallel()
+
+	// A record with an EACC ideograph that is not in the tables
+	rec := Record{}
+	rec.Leader, _ = NewLeader([]byte("00000cam  2200000 i 4500"))
+	rec.Fields = []Field{
+		{Tag: "001", Value: "ocm12345678"},
+		{Tag: "245", Indicator1: "1", Indicator2: "0", SubFields: []SubField{{Code: "a", Value: "\x1b$1\x21\x30\x21\x1b(B /"}}},
+	}
+	data, err := rec.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	f := NewMarcFile(bytes.NewBuffer(data))
+	f.ConvertMarc8(true)
+	f.Scan()
+	got, err := f.Record()
+	if err != ErrUnmappedMarc8 {
+		t.Fatalf("expected error %v, got %v", ErrUnmappedMarc8, err)
+	}
+	if got.Leader.CharCoding != ' ' {
+		t.Errorf("expected leader/09 to be blank, got %q", got.Leader.CharCoding)
+	}
+	if diff := cmp.Diff(rec.Fields, got.Fields); diff != "" {
+		t.Errorf("fields mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestMarc8EncoderEncode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "ASCII", input: "Coal sampling.", want: "Coal sampling."},
+		{name: "precomposed character", input: "Café", want: "Caf\xe2e"},
+		{name: "decomposed character", input: "Cafe\u0301", want: "Caf\xe2e"},
+		{name: "Vietnamese", input: "Việt", want: "Vi\xf2\xe3et"},
+		{name: "ANSEL spacing characters", input: "Łódź ©", want: "\xa1\xe2od\xe2z \xc3"},
+		{name: "Cyrillic", input: "аб x", want: "\x1b(NAB \x1b(Bx"},
+		{name: "subscript", input: "H₂O", want: "H\x1bb2\x1bsO"},
+		{name: "Hebrew at the end", input: "אב", want: "\x1b(2`a\x1b(B"},
+		{name: "kana", input: "あ", want: "\x1b$1\x69\x24\x22\x1b(B"},
+		{name: "Greek", input: "Λόγος", want: "\x1b(SN\xe2rdrw\x1b(B"},
+		{name: "Greek with dialytika and tonos", input: "ΐ", want: "\xe8\xe2\x1b(Sl\x1b(B"},
+		{name: "Vietnamese with horn", input: "Ớ", want: "\xe2\xac"},
+		{name: "singleton decomposition", input: "Ω", want: "\x1b(S]\x1b(B"},
+		{name: "substitution", input: "中文", want: "&#x4E2D;&#x6587;"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := NewMarc8Encoder(true)
+			got, err := e.Encode(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMarc8EncoderUnmapped(t *testing.T) {
+	t.Parallel()
+
+	e := NewMarc8Encoder(false)
+	_, err := e.Encode("abc 中")
+	if _, ok := err.(*UnmappableCharError); !ok {
+		t.Errorf("expected UnmappableCharError, got %v", err)
+	}
+	if e.Unmapped['中'] != 1 {
+		t.Errorf("expected unmapped character to be reported, got %v", e.Unmapped)
+	}
+}
+
+func TestMarc8RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	input := "Müller, Łukasz: H₂O аб Ґ אב あア ©℗"
+	e := NewMarc8Encoder(false)
+	encoded, err := e.Encode(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := DecodeMarc8(encoded)
+	want := "Mu\u0308ller, \u0141ukasz: H\u2082O \u0430\u0431 \u0490 \u05d0\u05d1 \u3042\u30a2 \u00a9\u2117"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestMarc8RoundTripHanGreek(t *testing.T) {
+	t.Parallel()
+
+	// Han characters have no table and are written as numeric character
+	// references, they must come back unchanged.
+	input := "夏目漱石. こころ. Λόγος, ἡ ψυχή"
+	e := NewMarc8Encoder(true)
+	encoded, err := e.Encode(input)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, mapped := decodeMarc8(encoded)
+	if !mapped {
+		t.Errorf("expected all characters to be mapped in %q", encoded)
+	}
+	want := "夏目漱石. こころ. Λο\u0301γος, η\u0314 ψυχη\u0301"
+	if got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestMarc8EncoderEncodeRecord(t *testing.T) {
+	t.Parallel()
+
+	want := setUpTestRecord("testdata/test_1a.mrc", t)
+	want.Fields[0].Value = "Façade"
+
+	e := NewMarc8Encoder(false)
+	r, err := e.EncodeRecord(want)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Leader.CharCoding != ' ' {
+		t.Errorf("expected leader/09 to be blank, got %q", r.Leader.CharCoding)
+	}
+
+	f := NewMarcFile(bytes.NewBuffer(r.Raw()))
+	f.ConvertMarc8(true)
+	f.Scan()
+	got, err := f.Record()
+	if err != nil {
+		t.Fatalf("problem calling Record on MarcFile: %s", err)
+	}
+	if got.Fields[0].Value != "Fac\u0327ade" {
+		t.Errorf("expected %q, got %q", "Fac\u0327ade", got.Fields[0].Value)
+	}
+	if len(got.Fields) != len(want.Fields) {
+		t.Errorf("expected %d fields, got %d", len(want.Fields), len(got.Fields))
+	}
+	if got.GetValue("245", "a") != want.GetValue("245", "a") {
+		t.Errorf("expected %q, got %q", want.GetValue("245", "a"), got.GetValue("245", "a"))
+	}
+}
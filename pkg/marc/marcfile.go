@@ -3,10 +3,12 @@ package marc
 import (
 	"bufio"
 	"bytes"
+	"compress/bzip2"
+	"compress/gzip"
 	"encoding/xml"
 	"errors"
 	"fmt"
-	"os"
+	"io"
 	"strconv"
 )
 
@@ -54,35 +56,66 @@ type MarcFile struct {
 	decoder *xml.Decoder
 	isXML   bool
 	element xml.StartElement
+	err     error
+	marc8   bool
+	seeker  io.ReadSeeker // only for indexed files
+	index   Index
 }
 
-func isXML(file *os.File) bool {
-	buf := make([]byte, 5)
-	n, err := file.Read(buf)
-	if err != nil {
-		// hacky, probably a better way to do this
-		panic(err)
+// isXML peeks at the first bytes of the reader to determine whether
+// the data is MARC XML. It does not consume any bytes so the reader
+// can be used as-is afterwards (no need to seek back to the beginning).
+func isXML(reader *bufio.Reader) bool {
+	buf, _ := reader.Peek(5)
+	return string(buf) == "<?xml"
+}
+
+// decompress peeks at the magic bytes of the reader and, if the data is
+// compressed with gzip or bzip2, returns a reader that decompresses it
+// on the fly. Uncompressed data is returned untouched.
+func decompress(reader *bufio.Reader) (*bufio.Reader, error) {
+	magic, _ := reader.Peek(3)
+	if len(magic) >= 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		gz, err := gzip.NewReader(reader)
+		if err != nil {
+			return reader, err
+		}
+		return bufio.NewReader(gz), nil
 	}
-	// rewind file to get those 5 bytes back
-	file.Seek(0, 0)
-	return string(buf[:n]) == "<?xml"
+	if string(magic) == "BZh" {
+		return bufio.NewReader(bzip2.NewReader(reader)), nil
+	}
+	return reader, nil
 }
 
-// NewMarcFile creates a struct to handle reading the MARC file.
-func NewMarcFile(file *os.File) MarcFile {
+// NewMarcFile creates a struct to handle reading the MARC data in
+// the reader. The reader can be a file, os.Stdin, a network stream,
+// a bytes.Buffer, et cetera since the format is detected without
+// seeking back in the stream. Data compressed with gzip or bzip2 is
+// decompressed transparently.
+func NewMarcFile(r io.Reader) MarcFile {
+	reader, err := decompress(bufio.NewReader(r))
+	if err != nil {
+		return MarcFile{err: err}
+	}
 
-	if isXML(file) {
+	if isXML(reader) {
 		// For MARC XML files it uses a Decoder() to read one
 		// MARC record at a time.
-		decoder := xml.NewDecoder(file)
+		decoder := xml.NewDecoder(reader)
 		return MarcFile{decoder: decoder, isXML: true}
 	}
 
 	// Assume MARC binary
-	//
+	return MarcFile{scanner: newBinaryScanner(reader)}
+}
+
+// newBinaryScanner creates the scanner to read the records of a MARC
+// binary file.
+func newBinaryScanner(r io.Reader) *bufio.Scanner {
 	// For MARC binary files uses a Scanner() to read the
 	// contents of the file (stolen from https://github.com/MITLibraries/fml)
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(r)
 
 	// By default Scanner.Scan() returns "bufio.Scanner: token too long" if
 	// the block to read is longer than 64K. Since MARC records can be up to
@@ -92,7 +125,7 @@ func NewMarcFile(file *os.File) MarcFile {
 	scanner.Buffer(initialBuffer, customMaxSize)
 
 	scanner.Split(splitFunc)
-	return MarcFile{scanner: scanner}
+	return scanner
 }
 
 func splitFunc(data []byte, atEOF bool) (advance int, token []byte, err error) {
@@ -100,19 +133,39 @@ func splitFunc(data []byte, atEOF bool) (advance int, token []byte, err error) {
 		return 0, nil, nil
 	}
 
-	if atEOF {
-		return len(data), data, nil
-	}
-
+	// Look for the record terminator first, even at EOF, since some
+	// readers (e.g. gzip) return the last chunk of data with io.EOF.
 	if i := bytes.IndexByte(data, rt); i >= 0 {
 		return i + 1, data[0:i], nil
 	}
 
+	if atEOF {
+		return len(data), data, nil
+	}
+
 	return 0, nil, nil
 }
 
+// IsXML returns true if the file is in MARC XML format.
+func (file *MarcFile) IsXML() bool {
+	return file.isXML
+}
+
+// ConvertMarc8 indicates whether binary records encoded in MARC-8
+// (leader/09 blank) should be converted to UTF-8 as they are read.
+// Converted records get an "a" in leader/09. Records with characters
+// that cannot be converted are left in MARC-8 and Record returns them
+// along with ErrUnmappedMarc8. Notice that Record.Data still holds the
+// bytes as they were read from the file.
+func (file *MarcFile) ConvertMarc8(convert bool) {
+	file.marc8 = convert
+}
+
 // Err returns the error in the scanner (if any)
 func (file *MarcFile) Err() error {
+	if file.err != nil {
+		return file.err
+	}
 	if file.isXML {
 		return nil
 	}
@@ -122,11 +175,17 @@ func (file *MarcFile) Err() error {
 // Scan moves the scanner to the next record.
 // Returns false when no more records can be read.
 func (file *MarcFile) Scan() bool {
+	if file.err != nil {
+		return false
+	}
 
 	if file.isXML {
 		for {
-			token, _ := file.decoder.Token()
+			token, err := file.decoder.Token()
 			if token == nil {
+				if err != nil && err != io.EOF {
+					file.err = err
+				}
 				return false
 			}
 			// Find the next "<record>" element in the XML
@@ -165,6 +224,7 @@ func makeRecordFromXML(file *MarcFile, rec *Record) error {
 	leader, _ := NewLeader([]byte(xmlRec.Leader))
 	rec.Leader = leader
 	rec.Data = []byte("Raw data not supported in XML format\n")
+	rec.modified = true
 
 	// ...and then into a MARC Record.
 	for _, control := range xmlRec.ControlFields {
@@ -184,7 +244,13 @@ func makeRecordFromXML(file *MarcFile, rec *Record) error {
 
 func makeRecordFromBinary(file *MarcFile, rec *Record) error {
 	// Parse the bytes from the scanner to create the MARC Record.
-	recBytes := file.scanner.Bytes()
+	return makeRecordFromBytes(file.scanner.Bytes(), file.marc8, rec)
+}
+
+// makeRecordFromBytes parses the bytes of a MARC binary record (without
+// the record terminator). When convertMarc8 is true records encoded in MARC-8
+// are converted to UTF-8.
+func makeRecordFromBytes(recBytes []byte, convertMarc8 bool, rec *Record) error {
 	err := parseBytesIntoRecord(rec, recBytes)
 	if err != nil {
 		return err
@@ -200,11 +266,28 @@ func makeRecordFromBinary(file *MarcFile, rec *Record) error {
 	data := recBytes[start:]
 	dirs := recBytes[leaderLength : start-1]
 
-	return processDataIntoRecord(data, dirs, rec)
+	marc8 := convertMarc8 && rec.Leader.IsMarc8()
+	err = processDataIntoRecord(data, dirs, rec, marc8)
+	if err == ErrUnmappedMarc8 {
+		// Leave the record in MARC-8 rather than losing characters
+		rec.Fields = nil
+		if err = processDataIntoRecord(data, dirs, rec, false); err == nil {
+			err = ErrUnmappedMarc8
+		}
+		return err
+	}
+	if err == nil && marc8 {
+		rec.Leader.set(9, 'a')
+		rec.modified = true
+	}
+	return err
 }
 
 func parseBytesIntoRecord(rec *Record, recBytes []byte) error {
 	rec.Data = append([]byte(nil), recBytes...)
+	if len(recBytes) < leaderLength {
+		return ErrBadRecordLength
+	}
 	leader, err := NewLeader(recBytes[:leaderLength])
 	if err != nil {
 		return err
@@ -214,9 +297,8 @@ func parseBytesIntoRecord(rec *Record, recBytes []byte) error {
 	return nil
 }
 
-func processDataIntoRecord(data, dirs []byte, rec *Record) error {
-	// TODO: make this magic number a constant
-	for len(dirs) >= 12 {
+func processDataIntoRecord(data, dirs []byte, rec *Record, marc8 bool) error {
+	for len(dirs) >= directoryEntryLength {
 		tag := string(dirs[:tagEnd])
 		length, err := strconv.Atoi(string(dirs[lengthOfFieldStart:lengthOfFieldEnd]))
 		if err != nil {
@@ -234,14 +316,20 @@ func processDataIntoRecord(data, dirs []byte, rec *Record) error {
 		fdata := data[begin : begin+length-1] // length includes field terminator
 		// TODO: make this magic number a constant
 		if len(fdata) > 4 { // ignore illegal data
+			if marc8 {
+				value, mapped := decodeMarc8(fdata)
+				if !mapped {
+					return ErrUnmappedMarc8
+				}
+				fdata = []byte(value)
+			}
 			df, err := MakeField(tag, fdata)
 			if err != nil {
 				return err
 			}
 			rec.Fields = append(rec.Fields, df)
 		}
-		// TODO: make this magic number a constant
-		dirs = dirs[12:]
+		dirs = dirs[directoryEntryLength:]
 	}
 	return nil
 }
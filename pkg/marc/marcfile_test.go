@@ -2,7 +2,9 @@ package marc
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/xml"
+	"io/ioutil"
 	"os"
 	"testing"
 
@@ -75,7 +77,7 @@ func TestRecord(t *testing.T) {
 			t.Fatalf("problem calling Record on MarcFile: %s", err)
 		}
 
-		opt := cmp.AllowUnexported(Leader{})
+		opt := cmp.AllowUnexported(Leader{}, Record{})
 
 		if !cmp.Equal(want, got, opt) {
 			t.Errorf("expected %q, got %q", want, got)
@@ -84,6 +86,68 @@ func TestRecord(t *testing.T) {
 	}
 }
 
+func TestNewMarcFileFromReader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		path  string
+		isXML bool
+		count int
+	}{
+		{name: "binary", path: "testdata/test_10.mrc", isXML: false, count: 10},
+		{name: "XML", path: "testdata/test_10.xml", isXML: true, count: 10},
+		{name: "gzip binary", path: "testdata/test_10.mrc.gz", isXML: false, count: 10},
+		{name: "bzip2 XML", path: "testdata/test_10.xml.bz2", isXML: true, count: 10},
+		{name: "empty", path: "", isXML: false, count: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data []byte
+			if tt.path != "" {
+				var err error
+				data, err = ioutil.ReadFile(tt.path)
+				if err != nil {
+					t.Fatalf("error reading file: %v", err)
+				}
+			}
+
+			f := NewMarcFile(bytes.NewBuffer(data))
+			if f.isXML != tt.isXML {
+				t.Errorf("expected isXML to be %v", tt.isXML)
+			}
+
+			got := 0
+			for f.Scan() {
+				if _, err := f.Record(); err != nil {
+					t.Fatalf("problem calling Record on MarcFile: %s", err)
+				}
+				got++
+			}
+			if got != tt.count {
+				t.Errorf("expected %d records, got %d", tt.count, got)
+			}
+			if err := f.Err(); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestNewMarcFile_ErrorsOnBadGzip(t *testing.T) {
+	t.Parallel()
+
+	// gzip magic bytes followed by garbage
+	f := NewMarcFile(bytes.NewBufferString("\x1f\x8bnot really gzip"))
+	if f.Scan() {
+		t.Error("expected Scan to return false")
+	}
+	if f.Err() == nil {
+		t.Error("want error for invalid gzip data")
+	}
+}
+
 func setUpTestFile(path string, t *testing.T) *os.File {
 	t.Helper()
 
@@ -106,7 +170,8 @@ func newRecord(isXML bool, t *testing.T) Record {
 	}
 
 	return Record{
-		Data: rawData,
+		Data:     rawData,
+		modified: isXML,
 		Fields: []Field{
 			{
 				Tag:        "001",
@@ -537,6 +602,7 @@ func newRecord(isXML bool, t *testing.T) Record {
 			Type:          byte('a'),
 			BibLevel:      byte('m'),
 			Control:       byte(' '),
+			CharCoding:    byte('a'),
 			EncodingLevel: byte(' '),
 			Form:          byte('i'),
 			Multipart:     byte(' '),
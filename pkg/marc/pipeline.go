@@ -0,0 +1,115 @@
+package marc
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrStopPipeline can be returned by an OutputFunc to stop a pipeline
+// before all the records in the file have been read (e.g. once enough
+// records have been output). RunPipeline does not report it as an error.
+var ErrStopPipeline = errors.New("pipeline stopped")
+
+// ProcessFunc processes a record in a pipeline. It receives the position
+// of the record in the pipeline (zero based), the record, and the error
+// (if any) found parsing it and returns the result to be passed to the
+// OutputFunc. ProcessFunc is called from several goroutines at the same
+// time so it must be safe for concurrent use.
+type ProcessFunc func(i int, rec Record, err error) interface{}
+
+// OutputFunc receives the results of the ProcessFunc one at a time and
+// in the same order as the records in the file.
+type OutputFunc func(result interface{}) error
+
+// pipelineJob is a record on its way through the pipeline.
+type pipelineJob struct {
+	i      int    // position of the record in the pipeline
+	data   []byte // bytes of a MARC binary record
+	rec    Record // record already parsed (MARC XML)
+	err    error
+	parsed bool
+	result chan interface{}
+}
+
+// RunPipeline reads the records in the file and processes them in
+// parallel with a pool of workers. A single goroutine splits the file
+// into records, the workers parse each record and call process with it,
+// and output is called with the results in the order of the records in
+// the file. MARC XML records are parsed while the file is split since
+// the XML decoder cannot be shared, only process runs in the workers for
+// them.
+//
+// The pipeline stops when all the records have been processed or when
+// output returns an error. The error is returned, except for
+// ErrStopPipeline.
+func (file *MarcFile) RunPipeline(workers int, process ProcessFunc, output OutputFunc) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	// Jobs are queued for the workers and, in the same order, for the
+	// output. Both queues are bounded so that only a few records are kept
+	// in memory at any given time.
+	jobs := make(chan *pipelineJob, workers*2)
+	ordered := make(chan *pipelineJob, workers*2)
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		defer close(ordered)
+		defer close(jobs)
+		for i := 0; file.Scan(); i++ {
+			job := &pipelineJob{i: i, result: make(chan interface{}, 1)}
+			if file.isXML {
+				job.rec, job.err = file.Record()
+				job.parsed = true
+			} else {
+				// Copy the bytes since the scanner reuses its buffer.
+				job.data = append([]byte(nil), file.scanner.Bytes()...)
+			}
+			select {
+			case jobs <- job:
+			case <-done:
+				return
+			}
+			select {
+			case ordered <- job:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for job := range jobs {
+				rec, err := job.rec, job.err
+				if !job.parsed {
+					err = makeRecordFromBytes(job.data, file.marc8, &rec)
+				}
+				job.result <- process(job.i, rec, err)
+			}
+		}()
+	}
+
+	var err error
+	for job := range ordered {
+		if err = output(<-job.result); err != nil {
+			break
+		}
+	}
+	close(done)
+	wg.Wait()
+
+	if err == ErrStopPipeline {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return file.Err()
+}
@@ -0,0 +1,95 @@
+package marc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRunPipeline(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		path    string
+		workers int
+	}{
+		{name: "binary one worker", path: "testdata/test_10.mrc", workers: 1},
+		{name: "binary many workers", path: "testdata/test_10.mrc", workers: 4},
+		{name: "gzip", path: "testdata/test_10.mrc.gz", workers: 3},
+		{name: "XML", path: "testdata/test_10.xml", workers: 4},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			want := []string{}
+			file := setUpTestFile(tt.path, t)
+			defer file.Close()
+			m := NewMarcFile(file)
+			for m.Scan() {
+				r, err := m.Record()
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				want = append(want, r.ControlNum())
+			}
+
+			got := []string{}
+			pipelineFile := setUpTestFile(tt.path, t)
+			defer pipelineFile.Close()
+			m = NewMarcFile(pipelineFile)
+			process := func(i int, r Record, err error) interface{} {
+				if err != nil {
+					return err.Error()
+				}
+				return r.ControlNum()
+			}
+			output := func(result interface{}) error {
+				got = append(got, result.(string))
+				return nil
+			}
+			if err := m.RunPipeline(tt.workers, process, output); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRunPipelineStop(t *testing.T) {
+	t.Parallel()
+
+	file := setUpTestFile("testdata/test_10.mrc", t)
+	defer file.Close()
+
+	var got int
+	m := NewMarcFile(file)
+	process := func(i int, r Record, err error) interface{} { return r }
+	output := func(result interface{}) error {
+		if got++; got == 3 {
+			return ErrStopPipeline
+		}
+		return nil
+	}
+	if err := m.RunPipeline(2, process, output); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != 3 {
+		t.Errorf("expected 3 records, got %d", got)
+	}
+
+	errOutput := errors.New("output error")
+	file = setUpTestFile("testdata/test_10.mrc", t)
+	defer file.Close()
+	m = NewMarcFile(file)
+	output = func(result interface{}) error { return errOutput }
+	if err := m.RunPipeline(2, process, output); err != errOutput {
+		t.Errorf("expected %v, got %v", errOutput, err)
+	}
+}
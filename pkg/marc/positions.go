@@ -0,0 +1,105 @@
+package marc
+
+import (
+	"errors"
+	"strings"
+)
+
+var ErrInvalidPositionFilter = errors.New("invalid position filter (must be TAG/NN[-NN]=value[,value] and the values must be as long as the positions)")
+
+// PositionFilter selects records by the values in some character
+// positions of the leader or a control field, e.g. serials (leader/07 s)
+// or records with Date1 (008/07-10) between 1900 and 1950.
+type PositionFilter struct {
+	Tag    string // LDR or a control field, e.g. 008
+	Start  int    // first position (zero based)
+	End    int    // last position plus one
+	Values []string
+	Ranges []PositionRange
+}
+
+// PositionRange is a range of values (inclusive) in a PositionFilter.
+// Values are compared as strings, which works as expected for numbers
+// since all the values have the same length.
+type PositionRange struct {
+	From string
+	To   string
+}
+
+// NewPositionFilter parses a string in the format TAG/NN[-NN]=values
+// where values is a comma delimited list of values or ranges of values
+// (e.g. 1900-1950) that the positions can have. Use # to indicate blanks.
+// Examples:
+//
+//	"LDR/07=s" serials.
+//	"LDR/05=c,d" corrected or deleted records.
+//	"008/07-10=1900-1950,1999" Date1 between 1900 and 1950 or 1999.
+func NewPositionFilter(str string) (PositionFilter, error) {
+	parts := strings.SplitN(str, "=", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return PositionFilter{}, ErrInvalidPositionFilter
+	}
+	field, err := NewFieldFilter(parts[0])
+	if err != nil || !field.HasPositions() {
+		return PositionFilter{}, ErrInvalidPositionFilter
+	}
+
+	filter := PositionFilter{Tag: field.Tag, Start: field.Start, End: field.End}
+	width := field.End - field.Start
+	for _, value := range strings.Split(strings.ReplaceAll(parts[1], "#", " "), ",") {
+		if len(value) == width {
+			filter.Values = append(filter.Values, value)
+		} else if len(value) == 2*width+1 && value[width] == '-' {
+			filter.Ranges = append(filter.Ranges, PositionRange{From: value[:width], To: value[width+1:]})
+		} else {
+			return PositionFilter{}, ErrInvalidPositionFilter
+		}
+	}
+	return filter, nil
+}
+
+// ParsePositionFilters parses a space delimited list of position
+// filters (see NewPositionFilter).
+func ParsePositionFilters(str string) ([]PositionFilter, error) {
+	filters := []PositionFilter{}
+	for _, value := range strings.Fields(str) {
+		filter, err := NewPositionFilter(value)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// Match returns true if the positions of the leader (or the control
+// field) of the record have one of the values of the filter.
+func (filter PositionFilter) Match(r Record) bool {
+	if filter.Tag == "LDR" {
+		return filter.matchValue(r.Leader.Raw())
+	}
+	for _, field := range r.FieldsByTag(filter.Tag) {
+		if filter.matchValue(field.Value) {
+			return true
+		}
+	}
+	return false
+}
+
+func (filter PositionFilter) matchValue(value string) bool {
+	if filter.End > len(value) {
+		return false
+	}
+	value = value[filter.Start:filter.End]
+	for _, v := range filter.Values {
+		if value == v {
+			return true
+		}
+	}
+	for _, r := range filter.Ranges {
+		if value >= r.From && value <= r.To {
+			return true
+		}
+	}
+	return false
+}
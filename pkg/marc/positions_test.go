@@ -0,0 +1,75 @@
+package marc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewPositionFilter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		str    string
+		filter PositionFilter
+		err    error
+	}{
+		{name: "leader value", str: "LDR/07=s", filter: PositionFilter{Tag: "LDR", Start: 7, End: 8, Values: []string{"s"}}},
+		{name: "value set", str: "LDR/05=c,d", filter: PositionFilter{Tag: "LDR", Start: 5, End: 6, Values: []string{"c", "d"}}},
+		{name: "blank", str: "LDR/09=#", filter: PositionFilter{Tag: "LDR", Start: 9, End: 10, Values: []string{" "}}},
+		{name: "range", str: "008/07-10=1900-1950,1999", filter: PositionFilter{Tag: "008", Start: 7, End: 11, Values: []string{"1999"}, Ranges: []PositionRange{{From: "1900", To: "1950"}}}},
+		{name: "no values", str: "LDR/07=", err: ErrInvalidPositionFilter},
+		{name: "no positions", str: "008=eng", err: ErrInvalidPositionFilter},
+		{name: "value too short", str: "008/07-10=19", err: ErrInvalidPositionFilter},
+		{name: "data field", str: "245/01=a", err: ErrInvalidPositionFilter},
+	}
+
+	for _, tt := range tests {
+		got, err := NewPositionFilter(tt.str)
+		if err != tt.err {
+			t.Errorf("%s: expected error %v, got %v", tt.name, tt.err, err)
+			continue
+		}
+		if diff := cmp.Diff(tt.filter, got); diff != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tt.name, diff)
+		}
+	}
+}
+
+func TestPositionFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	// Leader 01805nam a2200385 i 4500, 008 041206s1976    dcua ... eng c
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+
+	tests := []struct {
+		str  string
+		want bool
+	}{
+		{str: "LDR/07=m", want: true},
+		{str: "LDR/07=s", want: false},
+		{str: "LDR/06-07=am,as", want: true},
+		{str: "LDR/17=#", want: true},
+		{str: "008/07-10=1900-1950", want: false},
+		{str: "008/07-10=1950-1980", want: true},
+		{str: "008/07-10=1976", want: true},
+		{str: "008/35-37=spa,eng", want: true},
+		{str: "006/00=a", want: false},
+	}
+
+	for _, tt := range tests {
+		filter, err := NewPositionFilter(tt.str)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.str, err)
+		}
+		if got := filter.Match(record); got != tt.want {
+			t.Errorf("%s: expected %t, got %t", tt.str, tt.want, got)
+		}
+	}
+
+	filters, err := ParsePositionFilters("LDR/07=m  008/35-37=eng")
+	if err != nil || len(filters) != 2 {
+		t.Errorf("expected two filters, got %v (%v)", filters, err)
+	}
+}
@@ -0,0 +1,341 @@
+package marc
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// QueryError represents a syntax error in a query.
+type QueryError struct {
+	Position int // position (zero based) in the query where the error was found
+	Details  string
+}
+
+func (e *QueryError) Error() string {
+	return fmt.Sprintf("invalid query at position %d: %s", e.Position, e.Details)
+}
+
+// Query is a boolean expression to select records, see ParseQuery.
+type Query struct {
+	text string
+	root queryNode
+}
+
+// ParseQuery parses a query to select records. A query is made of tests
+// combined with AND, OR, NOT, and parentheses, for example:
+//
+//	245a ~ "^history" AND (650_0 OR 651) AND NOT 856u:*
+//
+// Each test starts with a selector followed by an optional operator and
+// value. The selector indicates the field (e.g. 245), and optionally the
+// indicators, the subfields, or the character positions to test, in the
+// same format supported by NewFieldFilter:
+//
+//	245       field 245
+//	6XX       any 6XX field
+//	245ab     subfields a and b of field 245
+//	650_0     field 650 with any first indicator (_) and second indicator 0
+//	650#0a    subfield a of field 650 with blank (#) first indicator and 0 second indicator
+//	245@10a   subfield a of field 245 with first indicator 1 and second indicator 0
+//	008/35-37 positions 35 to 37 (zero based) of control field 008
+//	LDR/06    position 06 of the leader
+//
+// The operators are:
+//
+//	(none)    the field (or subfield) exists, also written as :*
+//	: value   the value contains the text (case insensitive)
+//	~ value   the value matches the regular expression
+//	= value   the value is equal to the text
+//
+// Values can be quoted with double quotes, e.g. 245a:"the end", and they
+// must be quoted when they include spaces, parentheses, or operators.
+// A test is true when any of the selected values satisfies it.
+func ParseQuery(query string) (Query, error) {
+	p := queryParser{text: query}
+	if err := p.tokenize(); err != nil {
+		return Query{}, err
+	}
+	if len(p.tokens) == 0 {
+		return Query{}, &QueryError{Position: 0, Details: "query is empty"}
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return Query{}, err
+	}
+	if p.pos < len(p.tokens) {
+		token := p.tokens[p.pos]
+		return Query{}, &QueryError{Position: token.start, Details: fmt.Sprintf("unexpected %q", token.text)}
+	}
+	return Query{text: query, root: root}, nil
+}
+
+// Match returns true if the record satisfies the query. An empty query
+// matches every record.
+func (q Query) Match(r Record) bool {
+	if q.root == nil {
+		return true
+	}
+	return q.root.match(r)
+}
+
+func (q Query) String() string {
+	return q.text
+}
+
+type queryNode interface {
+	match(r Record) bool
+}
+
+type andNode struct {
+	left, right queryNode
+}
+
+func (n andNode) match(r Record) bool {
+	return n.left.match(r) && n.right.match(r)
+}
+
+type orNode struct {
+	left, right queryNode
+}
+
+func (n orNode) match(r Record) bool {
+	return n.left.match(r) || n.right.match(r)
+}
+
+type notNode struct {
+	node queryNode
+}
+
+func (n notNode) match(r Record) bool {
+	return !n.node.match(r)
+}
+
+// testNode tests the values selected in the record against a value.
+type testNode struct {
+	selector FieldFilter
+	operator string // "" for existence tests
+	value    string
+	regEx    *regexp.Regexp
+}
+
+func (n testNode) match(r Record) bool {
+	for _, value := range selectedValues(n.selector, r) {
+		switch n.operator {
+		case "":
+			return true
+		case ":":
+			if strings.Contains(strings.ToLower(value), n.value) {
+				return true
+			}
+		case "~":
+			if n.regEx.MatchString(value) {
+				return true
+			}
+		case "=":
+			if value == n.value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// selectedValues returns the values in the record selected by the filter.
+func selectedValues(filter FieldFilter, r Record) []string {
+	if filter.Tag == "LDR" {
+		if value, ok := filter.positions(r.Leader.Raw()); ok {
+			return []string{value}
+		}
+		return []string{}
+	}
+
+	values := []string{}
+	for _, field := range r.filterInclude(FieldFilters{Fields: []FieldFilter{filter}}) {
+		if field.IsControlField() {
+			values = append(values, field.Value)
+		}
+		for _, sub := range field.SubFields {
+			values = append(values, sub.Value)
+		}
+	}
+	return values
+}
+
+type queryToken struct {
+	text   string
+	start  int
+	quoted bool
+}
+
+type queryParser struct {
+	text   string
+	tokens []queryToken
+	pos    int
+}
+
+func isQueryOperator(c byte) bool {
+	return c == ':' || c == '~' || c == '='
+}
+
+// tokenize splits the query into parentheses, operators, quoted values,
+// and words (selectors, keywords, and unquoted values).
+func (p *queryParser) tokenize() error {
+	i := 0
+	for i < len(p.text) {
+		c := p.text[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(' || c == ')' || isQueryOperator(c):
+			p.tokens = append(p.tokens, queryToken{text: string(c), start: i})
+			i++
+		case c == '"':
+			start := i
+			value := []byte{}
+			for i++; i < len(p.text) && p.text[i] != '"'; i++ {
+				if p.text[i] == '\\' && i+1 < len(p.text) {
+					i++
+				}
+				value = append(value, p.text[i])
+			}
+			if i == len(p.text) {
+				return &QueryError{Position: start, Details: "missing closing quote"}
+			}
+			i++
+			p.tokens = append(p.tokens, queryToken{text: string(value), start: start, quoted: true})
+		default:
+			start := i
+			for i < len(p.text) && !strings.ContainsRune(" \t\n\r()\"", rune(p.text[i])) && !isQueryOperator(p.text[i]) {
+				i++
+			}
+			p.tokens = append(p.tokens, queryToken{text: p.text[start:i], start: start})
+		}
+	}
+	return nil
+}
+
+func (p *queryParser) peek() (queryToken, bool) {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos], true
+	}
+	return queryToken{}, false
+}
+
+func (p *queryParser) isKeyword(keyword string) bool {
+	token, ok := p.peek()
+	return ok && !token.quoted && strings.EqualFold(token.text, keyword)
+}
+
+func (p *queryParser) endError(details string) error {
+	return &QueryError{Position: len(p.text), Details: details}
+}
+
+func (p *queryParser) parseOr() (queryNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("OR") {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseAnd() (queryNode, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.isKeyword("AND") {
+		p.pos++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *queryParser) parseNot() (queryNode, error) {
+	if p.isKeyword("NOT") {
+		p.pos++
+		node, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return notNode{node: node}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *queryParser) parsePrimary() (queryNode, error) {
+	token, ok := p.peek()
+	if !ok {
+		return nil, p.endError("expected a test")
+	}
+
+	if token.text == "(" && !token.quoted {
+		p.pos++
+		node, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		closing, ok := p.peek()
+		if !ok {
+			return nil, p.endError("missing closing parenthesis")
+		}
+		if closing.text != ")" || closing.quoted {
+			return nil, &QueryError{Position: closing.start, Details: fmt.Sprintf("expected ) but found %q", closing.text)}
+		}
+		p.pos++
+		return node, nil
+	}
+
+	if token.quoted || token.text == ")" || isQueryOperator(token.text[0]) {
+		return nil, &QueryError{Position: token.start, Details: fmt.Sprintf("expected a field but found %q", token.text)}
+	}
+	p.pos++
+	selector, err := NewFieldFilter(token.text)
+	if err != nil {
+		return nil, &QueryError{Position: token.start, Details: fmt.Sprintf("%s: %s", token.text, err)}
+	}
+	node := testNode{selector: selector}
+
+	operator, ok := p.peek()
+	if !ok || operator.quoted || !isQueryOperator(operator.text[0]) {
+		// Existence test
+		return node, nil
+	}
+	p.pos++
+	value, ok := p.peek()
+	if !ok || (!value.quoted && (value.text == "(" || value.text == ")" || isQueryOperator(value.text[0]))) {
+		return nil, &QueryError{Position: operator.start, Details: fmt.Sprintf("expected a value after %q", operator.text)}
+	}
+	p.pos++
+
+	switch {
+	case operator.text == ":" && value.text == "*" && !value.quoted:
+		// Existence test
+	case operator.text == ":":
+		node.operator = ":"
+		node.value = strings.ToLower(value.text)
+	case operator.text == "~":
+		regEx, err := regexp.Compile(value.text)
+		if err != nil {
+			return nil, &QueryError{Position: value.start, Details: err.Error()}
+		}
+		node.operator = "~"
+		node.regEx = regEx
+	default:
+		node.operator = "="
+		node.value = value.text
+	}
+	return node, nil
+}
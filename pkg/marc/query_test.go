@@ -0,0 +1,98 @@
+package marc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestQueryMatch(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+
+	tests := []struct {
+		name  string
+		query string
+		want  bool
+	}{
+		{name: "field exists", query: "245", want: true},
+		{name: "field does not exist", query: "651", want: false},
+		{name: "subfield exists", query: "856u:*", want: true},
+		{name: "subfield does not exist", query: "245b", want: false},
+		{name: "substring case insensitive", query: "245a:GUIDELINES", want: true},
+		{name: "substring in any subfield", query: "650:sampling", want: true},
+		{name: "substring not found", query: "650a:sampling", want: false},
+		{name: "quoted substring", query: `245a:"chemical composition"`, want: true},
+		{name: "regex", query: `245a ~ "^Guide"`, want: true},
+		{name: "regex not found", query: `245a ~ "^guide"`, want: false},
+		{name: "equality", query: "650a = Coal", want: true},
+		{name: "equality is exact", query: "650a = coal", want: false},
+		{name: "second indicator", query: "650_0", want: true},
+		{name: "second indicator not found", query: "650_7", want: false},
+		{name: "blank first indicator", query: "650#0a = Coal", want: true},
+		{name: "first indicator", query: "2451_a:guidelines", want: true},
+		{name: "both indicators", query: "2451#a", want: false},
+		{name: "digit subfields are not indicators", query: "24510a:guidelines", want: true},
+		{name: "digit indicators", query: "245@10a:guidelines", want: true},
+		{name: "digit indicators not found", query: "245@14", want: false},
+		{name: "leader position", query: "LDR/06 = a", want: true},
+		{name: "leader positions", query: "LDR/06-07 = am", want: true},
+		{name: "control field positions", query: "008/35-37 = eng", want: true},
+		{name: "positions out of range", query: "008/50 = x", want: false},
+		{name: "control field substring", query: "001:57175940", want: true},
+		{name: "and", query: "245 AND 650", want: true},
+		{name: "or", query: "651 OR 650", want: true},
+		{name: "not", query: "NOT 651", want: true},
+		{name: "lowercase keywords", query: "245 and not 651", want: true},
+		{name: "and binds tighter than or", query: "650 OR 651 AND 652", want: true},
+		{name: "parentheses", query: "(650 OR 651) AND 652", want: false},
+		{name: "example", query: `245a ~ "^guide" OR (650_0 OR 651) AND NOT 856u:*`, want: false},
+		{name: "nested not", query: "NOT NOT 245", want: true},
+	}
+
+	for _, tt := range tests {
+		query, err := ParseQuery(tt.query)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if got := query.Match(record); got != tt.want {
+			t.Errorf("%s: expected %t for %q, got %t", tt.name, tt.want, tt.query, got)
+		}
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		query    string
+		position int
+	}{
+		{name: "empty", query: "  ", position: 0},
+		{name: "invalid field", query: "24", position: 0},
+		{name: "missing value", query: "245a:", position: 4},
+		{name: "missing test", query: "245 AND", position: 7},
+		{name: "missing parenthesis", query: "(245 OR 650", position: 11},
+		{name: "extra parenthesis", query: "245)", position: 3},
+		{name: "missing quote", query: `245a:"history`, position: 5},
+		{name: "invalid regex", query: `245a ~ "("`, position: 7},
+		{name: "positions in data field", query: "245/01", position: 0},
+		{name: "subfields in control field", query: "008a", position: 0},
+		{name: "invalid subfield", query: "245$a", position: 0},
+		{name: "missing operator", query: "245a history", position: 5},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseQuery(tt.query)
+		var queryErr *QueryError
+		if !errors.As(err, &queryErr) {
+			t.Errorf("%s: expected a QueryError, got %v", tt.name, err)
+			continue
+		}
+		if queryErr.Position != tt.position {
+			t.Errorf("%s: expected error at position %d, got %d (%v)", tt.name, tt.position, queryErr.Position, err)
+		}
+	}
+}
@@ -1,21 +1,45 @@
 package marc
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 )
 
+var (
+	ErrFieldNotFound = errors.New("field not found")
+	ErrInvalidTag    = errors.New("invalid tag (must be three characters)")
+)
+
 // Record is a struct representing a MARC record. It has a Fields slice
 // which contains both ControlFields and DataFields.
 type Record struct {
 	Data   []byte
 	Fields []Field
 	Leader Leader
+
+	// modified indicates that Data no longer matches the fields and the
+	// leader, e.g. the record was converted from MARC-8 or read from XML.
+	modified bool
+}
+
+// Modified returns true when Data is not the binary representation of
+// the record: records read from MARC XML, records converted from MARC-8,
+// records changed with AddField, InsertField, DeleteField, DeleteFields,
+// ReplaceField or Field, records with changes in the leader, and records
+// without Data. Use MarshalBinary to get the binary data of these records.
+func (r Record) Modified() bool {
+	if r.modified || len(r.Data) < leaderLength {
+		return true
+	}
+	return r.Leader.Raw() != string(r.Data[:leaderLength])
 }
 
 // Contains returns true if Record contains the value passed or matches the regEx passed.
 // If searchFieldList is an empty array it searches in all fields for the record
-// otherwise the search is limited to only the fields in the array.
+// otherwise the search is limited to only the fields in the array. The
+// fields in the array can be anything supported by NewFieldFilter (e.g.
+// 6XX, 650_0, or 245a) to limit the search to certain subfields too.
 func (r Record) Contains(searchValue string, searchRegEx string, searchFieldsList []string) bool {
 	if searchValue == "" && searchRegEx == "" {
 		return true
@@ -25,11 +49,13 @@ func (r Record) Contains(searchValue string, searchRegEx string, searchFieldsLis
 	if len(searchFieldsList) == 0 {
 		searchFields = r.Fields
 	} else {
-		for _, field := range r.Fields {
-			if r.arrayContains(searchFieldsList, field.Tag) {
-				searchFields = append(searchFields, field)
+		filters := FieldFilters{}
+		for _, value := range searchFieldsList {
+			if filter, err := NewFieldFilter(value); err == nil {
+				filters.Fields = append(filters.Fields, filter)
 			}
 		}
+		searchFields = r.filterInclude(filters)
 	}
 
 	for _, field := range searchFields {
@@ -46,6 +72,28 @@ func (r Record) HasFields(filters FieldFilters) bool {
 	return len(r.Filter(filters, exclude)) > 0
 }
 
+// HasAllFields returns true if the Record contains every one of the
+// fields indicated.
+func (r Record) HasAllFields(filters FieldFilters) bool {
+	for _, filter := range filters.Fields {
+		if len(r.filterInclude(FieldFilters{Fields: []FieldFilter{filter}})) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// LacksFields returns true if the Record is missing at least one of the
+// fields indicated, e.g. records without a 245 or without an 008.
+func (r Record) LacksFields(filters FieldFilters) bool {
+	return len(filters.Fields) > 0 && !r.HasAllFields(filters)
+}
+
+// LacksAllFields returns true if the Record has none of the fields indicated.
+func (r Record) LacksAllFields(filters FieldFilters) bool {
+	return len(r.filterInclude(filters)) == 0
+}
+
 // ControlNum returns the control number (tag 001) for the record.
 func (r Record) ControlNum() string {
 	for _, f := range r.Fields {
@@ -56,6 +104,9 @@ func (r Record) ControlNum() string {
 	return ""
 }
 
+// Raw returns the MARC binary data as it was read from the file.
+// Use MarshalBinary to get the binary data of a record that has been
+// modified.
 func (r Record) Raw() []byte {
 	// Include the record terminator.
 	return append(r.Data, rt)
@@ -94,25 +145,16 @@ func (r Record) Filter(include FieldFilters, exclude FieldFilters) []Field {
 func (r Record) filterInclude(filters FieldFilters) []Field {
 	list := []Field{}
 	for _, filter := range filters.Fields {
-		// Get all the fields in the record that match the tag
+		// Get all the fields in the record that match the filter
 		// (there could be more than one)
-		for _, field := range r.FieldsByTag(filter.Tag) {
-			if len(filter.Subfields) == 0 {
-				// add the value as-is, no need to filter by subfield
-				list = append(list, field)
-			} else {
-				// extract the indicated subfields from the field
-				// before adding it to the list
-				filteredField := Field{
-					Tag:        field.Tag,
-					Value:      field.Value,
-					Indicator1: field.Indicator1,
-					Indicator2: field.Indicator2,
-					SubFields:  field.GetSubFields(filter.Subfields),
-				}
-				if len(filteredField.SubFields) > 0 {
-					list = append(list, filteredField)
-				}
+		for _, field := range r.Fields {
+			if !filter.MatchField(field) {
+				continue
+			}
+			// extract the indicated subfields (or positions) from
+			// the field before adding it to the list
+			if filteredField, ok := filter.apply(field); ok {
+				list = append(list, filteredField)
 			}
 		}
 	}
@@ -123,14 +165,33 @@ func (r Record) filterExclude(filters FieldFilters) []Field {
 	list := []Field{}
 	for _, field := range r.Fields {
 		include := true
+		positions := []FieldFilter{}
 		for _, filter := range filters.Fields {
-			if len(filter.Subfields) > 0 {
-				panic("Exclude filter by subfields not supported yet")
+			if !filter.MatchField(field) {
+				continue
+			}
+			if filter.HasPositions() {
+				// the positions are removed once all the filters are
+				// checked since they refer to the original value
+				positions = append(positions, filter)
+				continue
 			}
-			if filter.Tag == field.Tag {
+			if len(filter.Subfields) == 0 {
 				include = false
 				break
 			}
+			// remove the indicated subfields and drop the field
+			// if there are no subfields left
+			field = field.withoutSubFields(filter.Subfields)
+			if len(field.SubFields) == 0 {
+				include = false
+				break
+			}
+		}
+		if include && len(positions) > 0 {
+			// drop the field if there is nothing left
+			field.Value = withoutPositions(field.Value, positions)
+			include = field.Value != ""
 		}
 		if include {
 			list = append(list, field)
@@ -190,11 +251,101 @@ func (r Record) GetValues(tag string, subfield string) []string {
 	return values
 }
 
-func (r Record) arrayContains(array []string, value string) bool {
-	for _, element := range array {
-		if element == value {
-			return true
+// AddField appends a field at the end of the record.
+func (r *Record) AddField(field Field) error {
+	if err := field.validate(); err != nil {
+		return err
+	}
+	r.Fields = append(r.Fields, field)
+	r.modified = true
+	return nil
+}
+
+// InsertField adds a field to the record keeping the fields in tag
+// order, i.e. the field is added after the last field with a tag less
+// than or equal to the tag of the new field.
+func (r *Record) InsertField(field Field) error {
+	if err := field.validate(); err != nil {
+		return err
+	}
+	i := len(r.Fields)
+	for i > 0 && r.Fields[i-1].Tag > field.Tag {
+		i--
+	}
+	r.Fields = append(r.Fields, Field{})
+	copy(r.Fields[i+1:], r.Fields[i:])
+	r.Fields[i] = field
+	r.modified = true
+	return nil
+}
+
+// DeleteField removes the nth (zero based) occurrence of the field
+// with the given tag.
+func (r *Record) DeleteField(tag string, occurrence int) error {
+	i := r.fieldIndex(tag, occurrence)
+	if i == -1 {
+		return ErrFieldNotFound
+	}
+	r.Fields = append(r.Fields[:i], r.Fields[i+1:]...)
+	r.modified = true
+	return nil
+}
+
+// DeleteFields removes all the fields with the given tag and returns
+// the number of fields removed.
+func (r *Record) DeleteFields(tag string) int {
+	fields := []Field{}
+	for _, field := range r.Fields {
+		if field.Tag != tag {
+			fields = append(fields, field)
 		}
 	}
-	return false
+	count := len(r.Fields) - len(fields)
+	r.Fields = fields
+	if count > 0 {
+		r.modified = true
+	}
+	return count
+}
+
+// ReplaceField replaces the nth (zero based) occurrence of the field
+// with the given tag with a new field.
+func (r *Record) ReplaceField(tag string, occurrence int, field Field) error {
+	if err := field.validate(); err != nil {
+		return err
+	}
+	i := r.fieldIndex(tag, occurrence)
+	if i == -1 {
+		return ErrFieldNotFound
+	}
+	r.Fields[i] = field
+	r.modified = true
+	return nil
+}
+
+// Field returns a pointer to the nth (zero based) occurrence of the
+// field with the given tag so that it can be modified in place, or
+// nil if there is no such field. Field is meant for editing only: the
+// record is considered modified (see Modified) as soon as the pointer
+// is returned, use FieldsByTag or GetValue to read the fields instead.
+func (r *Record) Field(tag string, occurrence int) *Field {
+	i := r.fieldIndex(tag, occurrence)
+	if i == -1 {
+		return nil
+	}
+	r.modified = true
+	return &r.Fields[i]
+}
+
+func (r Record) fieldIndex(tag string, occurrence int) int {
+	n := 0
+	for i, field := range r.Fields {
+		if field.Tag == tag {
+			if n == occurrence {
+				return i
+			}
+			n++
+		}
+	}
+	return -1
 }
@@ -26,6 +26,11 @@ func TestRecordContains(t *testing.T) {
 		{name: "case insensitive search non-empty searchFieldsList", searchValue: "coal", searchFieldsList: []string{"650"}, result: true},
 		{name: "empty searchFieldsList", searchValue: "Pizza", searchFieldsList: []string{}, result: false},
 		{name: "non-empty searchFieldsList", searchValue: "Coal", searchFieldsList: []string{"260"}, result: false},
+		{name: "wildcard searchFieldsList", searchValue: "Coal", searchFieldsList: []string{"6XX"}, result: true},
+		{name: "subfield searchFieldsList", searchValue: "sampling", searchFieldsList: []string{"650a"}, result: false},
+		{name: "indicators searchFieldsList", searchValue: "Coal", searchFieldsList: []string{"650_7"}, result: false},
+		{name: "positions searchFieldsList", searchValue: "eng", searchFieldsList: []string{"008/35-37"}, result: true},
+		{name: "positions searchFieldsList not found", searchValue: "eng", searchFieldsList: []string{"008/00-05"}, result: false},
 	}
 
 	for _, tt := range containsTests {
@@ -65,6 +70,42 @@ func TestHasFields(t *testing.T) {
 	}
 }
 
+func TestHasAndLacksFields(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+
+	tests := []struct {
+		name     string
+		fields   string
+		hasAny   bool
+		hasAll   bool
+		lacksAny bool
+		lacksAll bool
+	}{
+		{name: "all present", fields: "245,856", hasAny: true, hasAll: true, lacksAny: false, lacksAll: false},
+		{name: "some present", fields: "020,856", hasAny: true, hasAll: false, lacksAny: true, lacksAll: false},
+		{name: "none present", fields: "020,022", hasAny: false, hasAll: false, lacksAny: true, lacksAll: true},
+		{name: "subfields", fields: "245a,245b", hasAny: true, hasAll: false, lacksAny: true, lacksAll: false},
+	}
+
+	for _, tt := range tests {
+		filters := NewFieldFilters(tt.fields)
+		if got := record.HasFields(filters); got != tt.hasAny {
+			t.Errorf("%s: expected HasFields %t, got %t", tt.name, tt.hasAny, got)
+		}
+		if got := record.HasAllFields(filters); got != tt.hasAll {
+			t.Errorf("%s: expected HasAllFields %t, got %t", tt.name, tt.hasAll, got)
+		}
+		if got := record.LacksFields(filters); got != tt.lacksAny {
+			t.Errorf("%s: expected LacksFields %t, got %t", tt.name, tt.lacksAny, got)
+		}
+		if got := record.LacksAllFields(filters); got != tt.lacksAll {
+			t.Errorf("%s: expected LacksAllFields %t, got %t", tt.name, tt.lacksAll, got)
+		}
+	}
+}
+
 func TestFilter(t *testing.T) {
 	t.Parallel()
 
@@ -84,6 +125,42 @@ func TestFilter(t *testing.T) {
 			exclude: FieldFilters{},
 			result:  filterOnSubFields(record.FieldsByTag("650"), "a", t),
 		},
+		{
+			name:    "include tag wildcard and indicators",
+			include: FieldFilters{Fields: []FieldFilter{{Tag: "6XX", Ind2: "0"}, {Tag: "7..", Ind1: "0"}}},
+			exclude: FieldFilters{},
+			result:  record.FieldsByTag("650"),
+		},
+		{
+			name:    "include control field positions",
+			include: FieldFilters{Fields: []FieldFilter{{Tag: "008", Start: 35, End: 38}}},
+			exclude: FieldFilters{},
+			result:  []Field{{Tag: "008", Value: "eng"}},
+		},
+		{
+			name:    "exclude subfields",
+			include: FieldFilters{},
+			exclude: FieldFilters{Fields: []FieldFilter{{Tag: "0XX"}, {Tag: "1XX"}, {Tag: "2XX"}, {Tag: "3XX"}, {Tag: "4XX"}, {Tag: "5XX"}, {Tag: "7XX"}, {Tag: "8XX"}, {Tag: "9XX"}, {Tag: "650", Subfields: "x"}}},
+			result:  filterOnSubFields(record.FieldsByTag("650"), "a", t),
+		},
+		{
+			name:    "exclude all the subfields drops the field",
+			include: FieldFilters{},
+			exclude: FieldFilters{Fields: []FieldFilter{{Tag: "0XX"}, {Tag: "1XX"}, {Tag: "2XX"}, {Tag: "3XX"}, {Tag: "4XX"}, {Tag: "5XX"}, {Tag: "7XX"}, {Tag: "8XX"}, {Tag: "9XX"}, {Tag: "650", Subfields: "a"}, {Tag: "6XX", Subfields: "x"}}},
+			result:  []Field{},
+		},
+		{
+			name:    "exclude control field positions",
+			include: FieldFilters{},
+			exclude: FieldFilters{Fields: []FieldFilter{{Tag: "001"}, {Tag: "005"}, {Tag: "006"}, {Tag: "007"}, {Tag: "008", Start: 35, End: 38}, {Tag: "008", Start: 38, End: 39}, {Tag: "01X"}, {Tag: "02X"}, {Tag: "03X"}, {Tag: "04X"}, {Tag: "05X"}, {Tag: "06X"}, {Tag: "07X"}, {Tag: "08X"}, {Tag: "09X"}, {Tag: "1XX"}, {Tag: "2XX"}, {Tag: "3XX"}, {Tag: "4XX"}, {Tag: "5XX"}, {Tag: "6XX"}, {Tag: "7XX"}, {Tag: "8XX"}, {Tag: "9XX"}}},
+			result:  []Field{{Tag: "008", Value: "041206s1976    dcua    sb   f000 0 c"}},
+		},
+		{
+			name:    "exclude tag wildcard",
+			include: FieldFilters{},
+			exclude: FieldFilters{Fields: []FieldFilter{{Tag: "0XX"}, {Tag: "1XX"}, {Tag: "2XX"}, {Tag: "3XX"}, {Tag: "4XX"}, {Tag: "5XX"}, {Tag: "7XX"}, {Tag: "8XX"}, {Tag: "9XX"}}},
+			result:  record.FieldsByTag("650"),
+		},
 		{
 			name:    "empty include, long exclude",
 			include: FieldFilters{},
@@ -299,3 +376,121 @@ func filterOnSubFields(fields []Field, subfield string, t *testing.T) []Field {
 
 	return outFields
 }
+
+func TestInsertField(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	field := Field{Tag: "651", Indicator1: " ", Indicator2: "0", SubFields: []SubField{{Code: "a", Value: "Washington (D.C.)"}}}
+	if err := record.InsertField(field); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The new field goes after the existing 650 fields
+	i := record.fieldIndex("651", 0)
+	if record.Fields[i-1].Tag != "650" || record.Fields[i+1].Tag != "700" {
+		t.Errorf("field inserted in the wrong position (%d)", i)
+	}
+
+	bad := Field{Tag: "650", Indicator1: " ", Indicator2: "0", SubFields: []SubField{{Code: "a", Value: "Coal\x1eSampling"}}}
+	if err := record.InsertField(bad); err != ErrInvalidFieldValue {
+		t.Errorf("expected %q, got %q", ErrInvalidFieldValue, err)
+	}
+	if err := record.InsertField(Field{Tag: "001", Value: "ocm1\x1d"}); err != ErrInvalidFieldValue {
+		t.Errorf("expected %q, got %q", ErrInvalidFieldValue, err)
+	}
+	if err := record.InsertField(Field{Tag: "65"}); err != ErrInvalidTag {
+		t.Errorf("expected %q, got %q", ErrInvalidTag, err)
+	}
+}
+
+func TestAddDeleteReplaceField(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	count := len(record.Fields)
+
+	if err := record.AddField(Field{Tag: "999", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a", Value: "local"}}}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.Fields[len(record.Fields)-1].Tag != "999" {
+		t.Error("expected field to be added at the end")
+	}
+
+	if err := record.DeleteField("650", 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := record.GetValues("650", "x"); !cmp.Equal(got, []string{"Analysis."}) {
+		t.Errorf("expected only one 650 left, got %q", got)
+	}
+	if err := record.DeleteField("650", 1); err != ErrFieldNotFound {
+		t.Errorf("expected %q, got %q", ErrFieldNotFound, err)
+	}
+
+	if n := record.DeleteFields("910"); n != 2 {
+		t.Errorf("expected 2 fields removed, got %d", n)
+	}
+	if len(record.Fields) != count-2 {
+		t.Errorf("expected %d fields, got %d", count-2, len(record.Fields))
+	}
+
+	if err := record.ReplaceField("001", 0, Field{Tag: "001", Value: "new001"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if record.ControlNum() != "new001" {
+		t.Errorf("expected %q, got %q", "new001", record.ControlNum())
+	}
+
+	// Edit a field in place
+	record.Field("245", 0).SetSubField("a", "New title")
+
+	data, err := record.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	f := NewMarcFile(bytes.NewBuffer(data))
+	f.Scan()
+	got, err := f.Record()
+	if err != nil {
+		t.Fatalf("problem calling Record on MarcFile: %s", err)
+	}
+	if !cmp.Equal(record.Fields, got.Fields) {
+		t.Error(cmp.Diff(record.Fields, got.Fields))
+	}
+	if got.GetValue("245", "a") != "New title" {
+		t.Errorf("expected %q, got %q", "New title", got.GetValue("245", "a"))
+	}
+}
+
+func TestModified(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	if record.Modified() {
+		t.Errorf("expected record read from MARC binary not to be modified")
+	}
+	record.DeleteFields("999")
+	if record.Modified() {
+		t.Errorf("expected record not to be modified when no fields are deleted")
+	}
+	record.DeleteFields("945")
+	if !record.Modified() {
+		t.Errorf("expected record to be modified after deleting a field")
+	}
+
+	record = setUpTestRecord("testdata/test_1a.mrc", t)
+	if err := record.Leader.SetStatus('d'); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !record.Modified() {
+		t.Errorf("expected record to be modified after changing the leader")
+	}
+
+	xml := setUpTestRecord("testdata/test_10.xml", t)
+	if !xml.Modified() {
+		t.Errorf("expected record read from MARC XML to be modified")
+	}
+	if !(Record{}).Modified() {
+		t.Errorf("expected record without data to be modified")
+	}
+}
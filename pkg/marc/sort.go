@@ -0,0 +1,344 @@
+package marc
+
+import (
+	"bufio"
+	"container/heap"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidSortKey = errors.New("invalid sort key (must be a field, subfields, or positions in the leader or a control field, e.g. 001, 050ab, 245a, or 008/07-10)")
+
+// SortKey indicates how to sort records.
+type SortKey struct {
+	Field      FieldFilter // e.g. 001, 050ab, LDR/06-07, or 008/07-10
+	Numeric    bool        // compare the first number in the values rather than the strings
+	Descending bool
+}
+
+// NewSortKey creates a SortKey for the given field (see NewFieldFilter).
+// Only the first field that matches is used and when several subfields
+// are indicated their values are joined with a space. String comparisons
+// are case insensitive and skip the nonfiling characters of title fields
+// (e.g. the "The " in 245 with second indicator 4). Records without a
+// value sort before any other record (or after them in descending order).
+func NewSortKey(field string, numeric bool, descending bool) (SortKey, error) {
+	filter, err := NewFieldFilter(field)
+	if err != nil || filter.Tag == "" || strings.ContainsAny(filter.Tag, "Xx.") {
+		return SortKey{}, ErrInvalidSortKey
+	}
+	return SortKey{Field: filter, Numeric: numeric, Descending: descending}, nil
+}
+
+// Value returns the value of the key in the record (normalized for
+// comparison).
+func (key SortKey) Value(r Record) string {
+	if key.Field.Tag == "LDR" {
+		value, _ := key.Field.positions(r.Leader.Raw())
+		return key.normalize(value)
+	}
+
+	fields := r.filterInclude(FieldFilters{Fields: []FieldFilter{key.Field}})
+	if len(fields) == 0 {
+		return ""
+	}
+	field := fields[0]
+	if field.IsControlField() {
+		return key.normalize(field.Value)
+	}
+	values := []string{}
+	for _, sub := range field.SubFields {
+		values = append(values, sub.Value)
+	}
+	value := strings.Join(values, " ")
+	if skip := field.nonfilingCharacters(); len(field.SubFields) > 0 && field.SubFields[0].Code == "a" && skip < len(value) {
+		value = value[skip:]
+	}
+	return key.normalize(value)
+}
+
+func (key SortKey) normalize(value string) string {
+	value = strings.TrimSpace(value)
+	if key.Numeric {
+		return value
+	}
+	return strings.ToLower(value)
+}
+
+// Less returns true if the record with key value a sorts before the one
+// with key value b.
+func (key SortKey) Less(a string, b string) bool {
+	if key.Descending {
+		a, b = b, a
+	}
+	if key.Numeric {
+		numA, okA := firstNumber(a)
+		numB, okB := firstNumber(b)
+		if okA != okB {
+			return !okA
+		}
+		return numA < numB
+	}
+	return a < b
+}
+
+// firstNumber returns the first number in the value, e.g. 57175940 for
+// "ocm57175940" or 1976.5 for "c1976.5".
+func firstNumber(value string) (float64, bool) {
+	start := strings.IndexAny(value, "0123456789")
+	if start == -1 {
+		return 0, false
+	}
+	end := start
+	for end < len(value) && (value[end] >= '0' && value[end] <= '9' || value[end] == '.') {
+		end++
+	}
+	number, err := strconv.ParseFloat(strings.TrimRight(value[start:end], "."), 64)
+	return number, err == nil
+}
+
+// sortItem is a record along with its sort key. The sequence number
+// (the position of the record in the input) keeps the sort stable.
+type sortItem struct {
+	key  string
+	seq  uint64
+	data []byte
+}
+
+// Sorter sorts MARC records that do not fit in memory with an external
+// merge sort. Records are added with Add or AddFile, they are kept in
+// memory until they use the maximum memory indicated and then they are
+// sorted and written to a temporary file (a run). WriteTo merges the runs
+// into the sorted output. Records with the same key keep the order in
+// which they were added.
+type Sorter struct {
+	key       SortKey
+	tempDir   string
+	maxMemory int64
+	items     []sortItem
+	size      int64
+	seq       uint64
+	runs      []*os.File
+}
+
+// NewSorter creates a Sorter that keeps up to maxMemory bytes of records
+// in memory. The temporary files are created in tempDir, or in the
+// default directory for temporary files when tempDir is empty.
+func NewSorter(key SortKey, tempDir string, maxMemory int64) *Sorter {
+	return &Sorter{key: key, tempDir: tempDir, maxMemory: maxMemory}
+}
+
+// Add adds a record to sort. Records read from MARC binary files are
+// output unchanged, modified records (e.g. from MARC XML) are converted
+// to MARC binary.
+func (s *Sorter) Add(r Record) error {
+	data := r.Raw()
+	if r.Modified() {
+		var err error
+		if data, err = r.MarshalBinary(); err != nil {
+			return err
+		}
+	}
+
+	item := sortItem{key: s.key.Value(r), seq: s.seq, data: data}
+	s.items = append(s.items, item)
+	s.size += int64(len(item.key) + len(item.data))
+	s.seq++
+	if s.size >= s.maxMemory {
+		return s.writeRun()
+	}
+	return nil
+}
+
+// AddFile adds all the records in the file.
+func (s *Sorter) AddFile(file *MarcFile) error {
+	for file.Scan() {
+		r, err := file.Record()
+		if err != nil {
+			return err
+		}
+		if err := s.Add(r); err != nil {
+			return err
+		}
+	}
+	return file.Err()
+}
+
+func (s *Sorter) sortItems() {
+	sort.Slice(s.items, func(i, j int) bool {
+		return s.less(s.items[i], s.items[j])
+	})
+}
+
+func (s *Sorter) less(a sortItem, b sortItem) bool {
+	if s.key.Less(a.key, b.key) {
+		return true
+	}
+	if s.key.Less(b.key, a.key) {
+		return false
+	}
+	return a.seq < b.seq
+}
+
+// writeRun sorts the records in memory and writes them to a temporary
+// file.
+func (s *Sorter) writeRun() error {
+	s.sortItems()
+	file, err := ioutil.TempFile(s.tempDir, "marcli-sort-*.tmp")
+	if err != nil {
+		return err
+	}
+	s.runs = append(s.runs, file)
+
+	// Each record is written as the length of the key, the key, the length
+	// of the data, the data, and the sequence number. Write errors are
+	// reported by Flush.
+	w := bufio.NewWriter(file)
+	buf := make([]byte, binary.MaxVarintLen64)
+	for _, item := range s.items {
+		for _, value := range [][]byte{[]byte(item.key), item.data} {
+			n := binary.PutUvarint(buf, uint64(len(value)))
+			w.Write(buf[:n])
+			w.Write(value)
+		}
+		n := binary.PutUvarint(buf, item.seq)
+		w.Write(buf[:n])
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	s.items = nil
+	s.size = 0
+	return nil
+}
+
+// WriteTo writes the sorted records to w and removes the temporary
+// files.
+func (s *Sorter) WriteTo(w io.Writer) (int64, error) {
+	defer s.Close()
+
+	var written int64
+	if len(s.runs) == 0 {
+		// Everything fits in memory.
+		s.sortItems()
+		for _, item := range s.items {
+			n, err := w.Write(item.data)
+			written += int64(n)
+			if err != nil {
+				return written, err
+			}
+		}
+		return written, nil
+	}
+
+	if len(s.items) > 0 {
+		if err := s.writeRun(); err != nil {
+			return 0, err
+		}
+	}
+
+	merge := &sortMerge{sorter: s}
+	for _, run := range s.runs {
+		reader := bufio.NewReader(run)
+		item, err := readSortItem(reader)
+		if err == io.EOF {
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+		merge.runs = append(merge.runs, sortRun{reader: reader, item: item})
+	}
+	heap.Init(merge)
+
+	for merge.Len() > 0 {
+		run := &merge.runs[0]
+		n, err := w.Write(run.item.data)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+		item, err := readSortItem(run.reader)
+		if err == io.EOF {
+			heap.Pop(merge)
+			continue
+		}
+		if err != nil {
+			return written, err
+		}
+		run.item = item
+		heap.Fix(merge, 0)
+	}
+	return written, nil
+}
+
+// Close removes the temporary files.
+func (s *Sorter) Close() error {
+	var err error
+	for _, run := range s.runs {
+		run.Close()
+		if removeErr := os.Remove(run.Name()); err == nil {
+			err = removeErr
+		}
+	}
+	s.runs = nil
+	return err
+}
+
+func readSortItem(r *bufio.Reader) (sortItem, error) {
+	item := sortItem{}
+	values := [][]byte{}
+	for i := 0; i < 2; i++ {
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			if i > 0 && err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return item, err
+		}
+		value := make([]byte, length)
+		if _, err := io.ReadFull(r, value); err != nil {
+			return item, err
+		}
+		values = append(values, value)
+	}
+	seq, err := binary.ReadUvarint(r)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	item.key, item.data, item.seq = string(values[0]), values[1], seq
+	return item, err
+}
+
+// sortRun is a run being merged along with its next record.
+type sortRun struct {
+	reader *bufio.Reader
+	item   sortItem
+}
+
+// sortMerge is a heap of runs by their next record (see container/heap).
+type sortMerge struct {
+	sorter *Sorter
+	runs   []sortRun
+}
+
+func (m *sortMerge) Len() int { return len(m.runs) }
+func (m *sortMerge) Less(i, j int) bool {
+	return m.sorter.less(m.runs[i].item, m.runs[j].item)
+}
+func (m *sortMerge) Swap(i, j int)      { m.runs[i], m.runs[j] = m.runs[j], m.runs[i] }
+func (m *sortMerge) Push(x interface{}) { m.runs = append(m.runs, x.(sortRun)) }
+func (m *sortMerge) Pop() interface{} {
+	last := m.runs[len(m.runs)-1]
+	m.runs = m.runs[:len(m.runs)-1]
+	return last
+}
@@ -0,0 +1,160 @@
+package marc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSortKeyValue(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	record.InsertField(Field{Tag: "246", Indicator1: "1", Indicator2: "4", SubFields: []SubField{{Code: "a", Value: "The guidelines"}}})
+	record.InsertField(Field{Tag: "240", Indicator1: "1", Indicator2: "4", SubFields: []SubField{{Code: "a", Value: "The guidelines"}}})
+
+	tests := []struct {
+		field string
+		want  string
+	}{
+		{field: "001", want: "ocm57175940"},
+		{field: "LDR/06-07", want: "am"},
+		{field: "008/07-10", want: "1976"},
+		{field: "100aq", want: "swanson, vernon e. (vernon emmanuel),"},
+		{field: "240a", want: "guidelines"},
+		{field: "246a", want: "the guidelines"},
+		{field: "222a", want: ""},
+	}
+
+	for _, tt := range tests {
+		key, err := NewSortKey(tt.field, false, false)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.field, err)
+		}
+		if got := key.Value(record); got != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.field, tt.want, got)
+		}
+	}
+
+	if _, err := NewSortKey("6XX", false, false); err != ErrInvalidSortKey {
+		t.Errorf("expected error %v, got %v", ErrInvalidSortKey, err)
+	}
+}
+
+func TestSortKeyLess(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		numeric    bool
+		descending bool
+		a          string
+		b          string
+		want       bool
+	}{
+		{a: "abc", b: "abd", want: true},
+		{a: "", b: "abc", want: true},
+		{descending: true, a: "abc", b: "abd", want: false},
+		{a: "ocm9", b: "ocm10", want: false},
+		{numeric: true, a: "ocm9", b: "ocm10", want: true},
+		{numeric: true, a: "QA76.5", b: "QA76.45", want: false},
+		{numeric: true, a: "", b: "0", want: true},
+		{numeric: true, descending: true, a: "", b: "0", want: false},
+	}
+
+	for _, tt := range tests {
+		key := SortKey{Numeric: tt.numeric, Descending: tt.descending}
+		if got := key.Less(tt.a, tt.b); got != tt.want {
+			t.Errorf("%+v: expected %t for %q < %q, got %t", key, tt.want, tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestSorter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		file       string
+		field      string
+		numeric    bool
+		descending bool
+		maxMemory  int64
+	}{
+		{name: "in memory", file: "testdata/test_10.mrc", field: "001", descending: true, maxMemory: 1 << 20},
+		{name: "runs", file: "testdata/test_10.mrc", field: "001", descending: true, maxMemory: 5000},
+		{name: "one record per run", file: "testdata/test_10.mrc", field: "245a", maxMemory: 1},
+		{name: "numeric with ties", file: "testdata/test_10.mrc", field: "008/07-10", numeric: true, maxMemory: 4000},
+		{name: "xml", file: "testdata/test_10.xml", field: "005", maxMemory: 4000},
+	}
+
+	for _, tt := range tests {
+		key, err := NewSortKey(tt.field, tt.numeric, tt.descending)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+
+		tempDir, err := ioutil.TempDir("", "marcli-sort-test")
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		defer os.RemoveAll(tempDir)
+
+		file := setUpTestFile(tt.file, t)
+		sorter := NewSorter(key, tempDir, tt.maxMemory)
+		m := NewMarcFile(file)
+		if err := sorter.AddFile(&m); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		file.Close()
+
+		var buf bytes.Buffer
+		if _, err := sorter.WriteTo(&buf); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		if files, _ := ioutil.ReadDir(tempDir); len(files) > 0 {
+			t.Errorf("%s: %d temporary files were not removed", tt.name, len(files))
+		}
+
+		// The records must be in order, in the order of the file when
+		// they have the same key, and unchanged.
+		original := setUpTestFile(tt.file, t)
+		defer original.Close()
+		records := []Record{}
+		for m := NewMarcFile(original); m.Scan(); {
+			r, _ := m.Record()
+			records = append(records, r)
+		}
+		sorted := []Record{}
+		for m := NewMarcFile(&buf); m.Scan(); {
+			r, err := m.Record()
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", tt.name, err)
+			}
+			sorted = append(sorted, r)
+		}
+		if len(sorted) != len(records) {
+			t.Fatalf("%s: expected %d records, got %d", tt.name, len(records), len(sorted))
+		}
+
+		position := map[string]int{}
+		for i, r := range records {
+			position[r.ControlNum()] = i
+		}
+		for i := 1; i < len(sorted); i++ {
+			a, b := key.Value(sorted[i-1]), key.Value(sorted[i])
+			if key.Less(b, a) || (!key.Less(a, b) && position[sorted[i-1].ControlNum()] > position[sorted[i].ControlNum()]) {
+				t.Errorf("%s: records %d and %d out of order (%q, %q)", tt.name, i-1, i, a, b)
+			}
+		}
+		if tt.file == "testdata/test_10.mrc" {
+			for _, r := range sorted {
+				if diff := cmp.Diff(records[position[r.ControlNum()]].Raw(), r.Raw()); diff != "" {
+					t.Errorf("%s: record %s changed", tt.name, r.ControlNum())
+				}
+			}
+		}
+	}
+}
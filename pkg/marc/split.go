@@ -0,0 +1,112 @@
+package marc
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// SplitWriter writes MARC binary records to a sequence of files (parts).
+// A new part is started when the current one reaches the maximum number
+// of records or when the next record would make it larger than the
+// maximum number of bytes. Records are never split across parts, a
+// record larger than the maximum number of bytes gets a part on its own.
+type SplitWriter struct {
+	maxRecords int
+	maxBytes   int64
+	create     func(part int) (io.WriteCloser, error)
+	w          io.WriteCloser
+	part       int
+	records    int
+	bytes      int64
+}
+
+// NewSplitWriter creates a SplitWriter with a maximum number of records
+// and/or bytes per part (zero for no limit). The create function is
+// called to open each part, parts are numbered from one.
+func NewSplitWriter(maxRecords int, maxBytes int64, create func(part int) (io.WriteCloser, error)) *SplitWriter {
+	return &SplitWriter{maxRecords: maxRecords, maxBytes: maxBytes, create: create}
+}
+
+// SplitFilename returns the name for a part of a file, e.g. part 3 of
+// "data/load.mrc" is "data/load_0003.mrc". Parts are always MARC binary
+// so the extension of the file (and .gz or .bz2) is replaced with .mrc,
+// e.g. part 1 of "load.xml.gz" is "load_0001.mrc".
+func SplitFilename(filename string, part int) string {
+	name := filename
+	for _, ext := range []string{".gz", ".bz2"} {
+		if strings.HasSuffix(strings.ToLower(name), ext) {
+			name = name[:len(name)-len(ext)]
+		}
+	}
+	name = strings.TrimSuffix(name, filepath.Ext(name))
+	return fmt.Sprintf("%s_%04d.mrc", name, part)
+}
+
+// WriteRecord writes the MARC binary data of a record (including the
+// record terminator) to the current part, or to a new one if the current
+// part is full.
+func (w *SplitWriter) WriteRecord(data []byte) error {
+	full := w.w == nil ||
+		(w.maxRecords > 0 && w.records >= w.maxRecords) ||
+		(w.maxBytes > 0 && w.records > 0 && w.bytes+int64(len(data)) > w.maxBytes)
+	if full {
+		if err := w.Close(); err != nil {
+			return err
+		}
+		writer, err := w.create(w.part + 1)
+		if err != nil {
+			return err
+		}
+		w.w = writer
+		w.part++
+		w.records = 0
+		w.bytes = 0
+	}
+
+	n, err := w.w.Write(data)
+	w.bytes += int64(n)
+	w.records++
+	return err
+}
+
+// Parts returns the number of parts created so far.
+func (w *SplitWriter) Parts() int {
+	return w.part
+}
+
+// Close closes the current part.
+func (w *SplitWriter) Close() error {
+	if w.w == nil {
+		return nil
+	}
+	err := w.w.Close()
+	w.w = nil
+	return err
+}
+
+// Split writes all the records in the file to the SplitWriter. Records
+// read from MARC binary files are written as-is, records read from
+// MARC XML or converted from MARC-8 are converted to MARC binary.
+func (file *MarcFile) Split(w *SplitWriter) error {
+	for file.Scan() {
+		r, err := file.Record()
+		if err != nil {
+			return err
+		}
+		data := r.Raw()
+		if r.Modified() {
+			if data, err = r.MarshalBinary(); err != nil {
+				return err
+			}
+		}
+		if err := w.WriteRecord(data); err != nil {
+			return err
+		}
+	}
+	if err := file.Err(); err != nil {
+		return err
+	}
+	return w.Close()
+}
@@ -0,0 +1,106 @@
+package marc
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testPart struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (p *testPart) Close() error {
+	p.closed = true
+	return nil
+}
+
+func TestSplit(t *testing.T) {
+	t.Parallel()
+
+	original, err := ioutil.ReadFile("testdata/test_10.mrc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		file       string
+		maxRecords int
+		maxBytes   int64
+		want       []int // records in each part
+	}{
+		{name: "records", file: "testdata/test_10.mrc", maxRecords: 3, want: []int{3, 3, 3, 1}},
+		{name: "bytes", file: "testdata/test_10.mrc", maxBytes: 5000, want: []int{2, 2, 2, 3, 1}},
+		{name: "record larger than the limit", file: "testdata/test_10.mrc", maxBytes: 100, want: []int{1, 1, 1, 1, 1, 1, 1, 1, 1, 1}},
+		{name: "both", file: "testdata/test_10.mrc", maxRecords: 2, maxBytes: 100000, want: []int{2, 2, 2, 2, 2}},
+		{name: "xml", file: "testdata/test_10.xml", maxRecords: 5, want: []int{5, 5}},
+	}
+
+	for _, tt := range tests {
+		parts := []*testPart{}
+		w := NewSplitWriter(tt.maxRecords, tt.maxBytes, func(part int) (io.WriteCloser, error) {
+			if part != len(parts)+1 {
+				t.Errorf("%s: expected part %d, got %d", tt.name, len(parts)+1, part)
+			}
+			parts = append(parts, &testPart{})
+			return parts[len(parts)-1], nil
+		})
+
+		file := setUpTestFile(tt.file, t)
+		m := NewMarcFile(file)
+		if err := m.Split(w); err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.name, err)
+		}
+		file.Close()
+
+		got := []int{}
+		joined := []byte{}
+		for _, part := range parts {
+			if !part.closed {
+				t.Errorf("%s: part not closed", tt.name)
+			}
+			got = append(got, bytes.Count(part.Bytes(), []byte{rt}))
+			if tt.maxBytes > 0 && part.Len() > int(tt.maxBytes) && got[len(got)-1] > 1 {
+				t.Errorf("%s: part with %d bytes", tt.name, part.Len())
+			}
+			joined = append(joined, part.Bytes()...)
+		}
+		if diff := cmp.Diff(tt.want, got); diff != "" {
+			t.Errorf("%s: mismatch (-want +got):\n%s", tt.name, diff)
+		}
+		if tt.file == "testdata/test_10.mrc" && !bytes.Equal(joined, original) {
+			t.Errorf("%s: the parts do not add up to the original file", tt.name)
+		}
+		if w.Parts() != len(tt.want) {
+			t.Errorf("%s: expected %d parts, got %d", tt.name, len(tt.want), w.Parts())
+		}
+	}
+}
+
+func TestSplitFilename(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filename string
+		part     int
+		want     string
+	}{
+		{filename: "data/load.mrc", part: 3, want: "data/load_0003.mrc"},
+		{filename: "load", part: 12, want: "load_0012.mrc"},
+		{filename: "load.xml", part: 1, want: "load_0001.mrc"},
+		{filename: "load.mrc.gz", part: 1, want: "load_0001.mrc"},
+		{filename: "load.xml.BZ2", part: 2, want: "load_0002.mrc"},
+		{filename: "data.v2/load", part: 1, want: "data.v2/load_0001.mrc"},
+	}
+
+	for _, tt := range tests {
+		if got := SplitFilename(tt.filename, tt.part); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.filename, tt.want, got)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package marc
+
+import (
+	"sort"
+)
+
+// recordSizeBuckets are the upper limits (exclusive) of the buckets in the
+// histogram of record sizes. The last bucket has no upper limit.
+var recordSizeBuckets = []int{1024, 2048, 4096, 8192, 16384, 32768, 65536}
+
+// Stats is a profile of the records in a file: the fields and subfields
+// used, the values of their indicators, the types of records, their
+// encoding levels, and their sizes.
+type Stats struct {
+	Records        int                  `json:"records"`
+	ParseErrors    int                  `json:"parseErrors"`
+	Tags           map[string]*TagStats `json:"tags"`
+	RecordTypes    map[string]int       `json:"recordTypes"`    // leader/06-07
+	EncodingLevels map[string]int       `json:"encodingLevels"` // leader/17
+	RecordSizes    []SizeBucket         `json:"recordSizes"`
+}
+
+// TagStats are the statistics of a field. Indicators and subfield codes
+// are counted per occurrence of the field and blank indicators are
+// reported as "#".
+type TagStats struct {
+	Records     int            `json:"records"`     // records with the field
+	Occurrences int            `json:"occurrences"` // total number of fields
+	SubFields   map[string]int `json:"subfields,omitempty"`
+	Indicator1  map[string]int `json:"indicator1,omitempty"`
+	Indicator2  map[string]int `json:"indicator2,omitempty"`
+}
+
+// SizeBucket is a bucket in the histogram of record sizes, Max is zero
+// for the last bucket (no upper limit).
+type SizeBucket struct {
+	Min     int `json:"min"`
+	Max     int `json:"max"`
+	Records int `json:"records"`
+}
+
+// NewStats creates an empty Stats.
+func NewStats() *Stats {
+	stats := &Stats{
+		Tags:           map[string]*TagStats{},
+		RecordTypes:    map[string]int{},
+		EncodingLevels: map[string]int{},
+	}
+	min := 0
+	for _, max := range recordSizeBuckets {
+		stats.RecordSizes = append(stats.RecordSizes, SizeBucket{Min: min, Max: max - 1})
+		min = max
+	}
+	stats.RecordSizes = append(stats.RecordSizes, SizeBucket{Min: min})
+	return stats
+}
+
+// Add adds a record to the statistics.
+func (stats *Stats) Add(r Record) {
+	stats.Records++
+
+	if raw := r.Leader.Raw(); len(raw) == leaderLength {
+		stats.RecordTypes[raw[6:8]]++
+		stats.EncodingLevels[blankAsHash(raw[17:18])]++
+	}
+
+	size := len(r.Data) + 1
+	if r.Modified() {
+		// e.g. records read from MARC XML
+		if data, err := r.MarshalBinary(); err == nil {
+			size = len(data)
+		}
+	}
+	for i := range stats.RecordSizes {
+		if size >= stats.RecordSizes[i].Min && (size <= stats.RecordSizes[i].Max || stats.RecordSizes[i].Max == 0) {
+			stats.RecordSizes[i].Records++
+			break
+		}
+	}
+
+	seen := map[string]bool{}
+	for _, field := range r.Fields {
+		tag, ok := stats.Tags[field.Tag]
+		if !ok {
+			tag = &TagStats{}
+			stats.Tags[field.Tag] = tag
+		}
+		if !seen[field.Tag] {
+			seen[field.Tag] = true
+			tag.Records++
+		}
+		tag.Occurrences++
+		if field.IsControlField() {
+			continue
+		}
+
+		if tag.SubFields == nil {
+			tag.SubFields = map[string]int{}
+			tag.Indicator1 = map[string]int{}
+			tag.Indicator2 = map[string]int{}
+		}
+		tag.Indicator1[blankAsHash(field.Indicator1)]++
+		tag.Indicator2[blankAsHash(field.Indicator2)]++
+		for _, sub := range field.SubFields {
+			tag.SubFields[sub.Code]++
+		}
+	}
+}
+
+// AddError counts a record that could not be parsed.
+func (stats *Stats) AddError(err error) {
+	stats.ParseErrors++
+}
+
+// SortedTags returns the tags in the statistics in order.
+func (stats *Stats) SortedTags() []string {
+	tags := make([]string, 0, len(stats.Tags))
+	for tag := range stats.Tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// SortedKeys returns the keys of a map of counts (e.g. the subfield codes
+// of a TagStats) in order.
+func SortedKeys(counts map[string]int) []string {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func blankAsHash(value string) string {
+	if value == " " || value == "" {
+		return "#"
+	}
+	return value
+}
+
+// Stats reads all the records in the file and returns their statistics.
+// Records that cannot be parsed are counted as parse errors rather than
+// stopping the process.
+func (file *MarcFile) Stats() (*Stats, error) {
+	stats := NewStats()
+	for file.Scan() {
+		r, err := file.Record()
+		if err != nil {
+			stats.AddError(err)
+			continue
+		}
+		stats.Add(r)
+	}
+	return stats, file.Err()
+}
@@ -0,0 +1,66 @@
+package marc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+
+	data, err := ioutil.ReadFile("testdata/test_10.mrc")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// Add records that cannot be parsed.
+	data = append(data, []byte("00026nam a2200025 i 4500\x1dgarbage\x1d")...)
+
+	m := NewMarcFile(bytes.NewReader(data))
+	stats, err := m.Stats()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stats.Records != 10 || stats.ParseErrors != 2 {
+		t.Errorf("expected 10 records and 2 parse errors, got %d and %d", stats.Records, stats.ParseErrors)
+	}
+
+	want650 := &TagStats{
+		Records:     10,
+		Occurrences: 19,
+		SubFields:   map[string]int{"a": 19, "v": 2, "x": 12, "z": 14},
+		Indicator1:  map[string]int{"#": 19},
+		Indicator2:  map[string]int{"0": 19},
+	}
+	if diff := cmp.Diff(want650, stats.Tags["650"]); diff != "" {
+		t.Errorf("650 mismatch (-want +got):\n%s", diff)
+	}
+
+	want001 := &TagStats{Records: 10, Occurrences: 10}
+	if diff := cmp.Diff(want001, stats.Tags["001"]); diff != "" {
+		t.Errorf("001 mismatch (-want +got):\n%s", diff)
+	}
+
+	if diff := cmp.Diff(map[string]int{"am": 10}, stats.RecordTypes); diff != "" {
+		t.Errorf("record types mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(map[string]int{"#": 6, "K": 3, "I": 1}, stats.EncodingLevels); diff != "" {
+		t.Errorf("encoding levels mismatch (-want +got):\n%s", diff)
+	}
+
+	sizes := []int{}
+	for _, bucket := range stats.RecordSizes {
+		sizes = append(sizes, bucket.Records)
+	}
+	if diff := cmp.Diff([]int{0, 7, 3, 0, 0, 0, 0, 0}, sizes); diff != "" {
+		t.Errorf("record sizes mismatch (-want +got):\n%s", diff)
+	}
+
+	tags := stats.SortedTags()
+	if tags[0] != "001" || len(tags) != len(stats.Tags) {
+		t.Errorf("unexpected sorted tags %v", tags)
+	}
+}
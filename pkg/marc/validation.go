@@ -0,0 +1,222 @@
+package marc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError describes a problem found when validating a record
+// against the MARC 21 rules. Tag is "LDR" for problems in the leader.
+type ValidationError struct {
+	Tag     string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Tag, e.Message)
+}
+
+// fieldRule defines the valid content of a field. Indicators hold the
+// allowed values ("*" for any value). Subfields maps the allowed codes
+// to whether they are repeatable and is nil when any code is allowed.
+type fieldRule struct {
+	repeatable bool
+	ind1       string
+	ind2       string
+	subfields  map[string]bool
+}
+
+var (
+	bibliographicRules = parseRules(bibliographicRulesTable)
+	authorityRules     = parseRules(authorityRulesTable)
+)
+
+// parseRules parses a rules table (see bibliographicRulesTable for
+// the syntax).
+func parseRules(table string) map[string]fieldRule {
+	rules := map[string]fieldRule{}
+	for _, line := range strings.Split(table, "\n") {
+		tokens := strings.Fields(line)
+		if len(tokens) == 0 {
+			continue
+		}
+		if len(tokens) != 2 && len(tokens) != 6 {
+			panic("invalid rule: " + line)
+		}
+
+		rule := fieldRule{repeatable: tokens[1] == "R"}
+		if len(tokens) == 6 {
+			rule.ind1 = strings.ReplaceAll(tokens[2], "#", " ")
+			rule.ind2 = strings.ReplaceAll(tokens[3], "#", " ")
+			if tokens[4] != "*" {
+				rule.subfields = map[string]bool{}
+				for _, codes := range []string{tokens[4], tokens[5]} {
+					for _, code := range strings.Trim(codes, "-") {
+						rule.subfields[string(code)] = codes == tokens[5]
+					}
+				}
+			}
+		}
+		rules[tokens[0]] = rule
+	}
+	return rules
+}
+
+// Validate checks the record against the MARC 21 bibliographic rules
+// (or the authority rules for authority records): valid tags,
+// repeatability of fields, allowed indicator values, allowed and
+// repeatable subfield codes, and the presence of the mandatory fields
+// (008 and 245, or 008 and a single 1XX heading for authorities). It
+// also includes the problems found in the leader. Local fields (9XX and
+// X9X) are not validated.
+func (r Record) Validate() []ValidationError {
+	errs := []ValidationError{}
+	for _, err := range r.Leader.Validate() {
+		errs = append(errs, ValidationError{Tag: "LDR", Message: err.Error()})
+	}
+
+	rules := bibliographicRules
+	required := []string{"008", "245"}
+	obsolete := obsoleteBibliographicTags
+	if r.Leader.IsAuthority() {
+		rules = authorityRules
+		required = []string{"008"}
+		obsolete = nil
+	}
+
+	counts := map[string]int{}
+	for _, field := range r.Fields {
+		counts[field.Tag] += 1
+		errs = append(errs, validateField(field, counts[field.Tag], rules, obsolete)...)
+	}
+
+	for _, tag := range required {
+		if counts[tag] == 0 {
+			errs = append(errs, ValidationError{Tag: tag, Message: "mandatory field is missing"})
+		}
+	}
+
+	if r.Leader.IsAuthority() {
+		headings := len(r.tracings('1'))
+		if headings == 0 {
+			errs = append(errs, ValidationError{Tag: "1XX", Message: "mandatory field is missing"})
+		} else if headings > 1 {
+			errs = append(errs, ValidationError{Tag: "1XX", Message: "record has more than one heading"})
+		}
+	}
+
+	if f008 := r.FieldsByTag("008"); len(f008) > 0 && len(f008[0].Value) != 40 {
+		errs = append(errs, ValidationError{Tag: "008", Message: fmt.Sprintf("must be 40 characters long, found %d", len(f008[0].Value))})
+	}
+	return errs
+}
+
+// validateField validates a single field against the rules. Occurrence
+// is the number of times the tag has been seen in the record (1-based).
+func validateField(field Field, occurrence int, rules map[string]fieldRule, obsolete []string) []ValidationError {
+	errs := []ValidationError{}
+	addError := func(format string, a ...interface{}) {
+		errs = append(errs, ValidationError{Tag: field.Tag, Message: fmt.Sprintf(format, a...)})
+	}
+
+	if !isValidTag(field.Tag) {
+		addError("invalid tag")
+		return errs
+	}
+
+	if isLocalTag(field.Tag) {
+		return errs
+	}
+
+	if containsString(obsolete, field.Tag) {
+		addError("obsolete tag")
+		return errs
+	}
+
+	rule, ok := rules[field.Tag]
+	if !ok {
+		addError("undefined tag")
+		return errs
+	}
+
+	if occurrence == 2 && !rule.repeatable {
+		addError("field is not repeatable")
+	}
+
+	if field.IsControlField() {
+		if field.Indicator1 != "" || field.Indicator2 != "" || len(field.SubFields) > 0 {
+			addError("control field cannot have indicators or subfields")
+		}
+		return errs
+	}
+
+	if !isValidIndicator(field.Indicator1, rule.ind1) {
+		addError("invalid first indicator %q", field.Indicator1)
+	}
+	if !isValidIndicator(field.Indicator2, rule.ind2) {
+		addError("invalid second indicator %q", field.Indicator2)
+	}
+
+	if len(field.SubFields) == 0 {
+		addError("field has no subfields")
+	}
+
+	seen := map[string]int{}
+	for _, sub := range field.SubFields {
+		seen[sub.Code] += 1
+		if !isValidSubfieldCode(sub.Code) {
+			addError("invalid subfield code %q", sub.Code)
+			continue
+		}
+		if rule.subfields == nil {
+			continue
+		}
+		repeatable, ok := rule.subfields[sub.Code]
+		if !ok {
+			if seen[sub.Code] == 1 {
+				addError("subfield $%s is not defined", sub.Code)
+			}
+			continue
+		}
+		if !repeatable && seen[sub.Code] == 2 {
+			addError("subfield $%s is not repeatable", sub.Code)
+		}
+	}
+	return errs
+}
+
+func isValidTag(tag string) bool {
+	if len(tag) != 3 {
+		return false
+	}
+	for _, c := range tag {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return tag != "000"
+}
+
+// isLocalTag returns true for the tags reserved for local use
+// (9XX, X9X, and 009).
+func isLocalTag(tag string) bool {
+	return tag[0] == '9' || tag[1] == '9' || tag == "009"
+}
+
+func isValidIndicator(value, allowed string) bool {
+	if allowed == "*" {
+		return true
+	}
+	if value == "" {
+		value = " "
+	}
+	return len(value) == 1 && strings.Contains(allowed, value)
+}
+
+func isValidSubfieldCode(code string) bool {
+	if len(code) != 1 {
+		return false
+	}
+	c := code[0]
+	return (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,411 @@
+package marc
+
+// MARC 21 bibliographic rules used by Validate. Each line defines a
+// field as:
+//
+//	tag repeatable ind1 ind2 non-repeatable-subfields repeatable-subfields
+//
+// Repeatable is R or NR. Indicators list the allowed values with # for
+// blank. A * in the indicators or subfields allows any value and a -
+// indicates that there are no subfields in that group. Control fields
+// only include the tag and whether they are repeatable.
+//
+// Local fields (9XX and X9X) are not validated.
+// See https://www.loc.gov/marc/bibliographic/
+const bibliographicRulesTable = `
+001 NR
+003 NR
+005 NR
+006 R
+007 R
+008 NR
+010 NR # # a bz8
+013 R # # abc6 def8
+015 R # # 6 aqz28
+016 R #7 # 2 az8
+017 R #8 # bdi26 az8
+018 NR # # a6 8
+020 R # # ac6 qz8
+022 R #01 # al26 myz8
+024 R 012378 #01 acd26 qz8
+025 R # # - a8
+026 R # # ce26 abd58
+027 R # # a6 qz8
+028 R 0123456 0123 ab6 q8
+030 R # # a6 z8
+031 R # # abcegmnopr26 dqstuyz8
+032 R # # ab6 8
+033 R #012 #012 36 abcp0128
+034 R 013 #01 adefgjrxyz236 bchkmnpst018
+035 R # # a6 z8
+036 NR # # ab6 8
+037 R #23 # ab36 cfgn58
+038 NR # # a6 8
+040 NR # # abce6 d8
+041 R #01 #7 26 abdefghijkmnpqrt38
+042 NR # # - a
+043 NR # # 6 abc0128
+044 NR # # 26 abc8
+045 NR #012 # 6 abc8
+046 R #123 # abcdejklmnop236 xz8
+047 R # #7 2 a8
+048 R # #7 2 ab8
+050 R #01 04 b36 a018
+051 R # # abc 8
+052 R #17 # a26 bd8
+055 R #01 0123456789 b2 a08
+060 R #01 04 b a8
+061 R # # c ab8
+066 NR # # ab c
+070 R 01 # b a08
+071 R # # - abc8
+072 R # 07 2 ax68
+074 R # # a z8
+080 R #01 # b2 ax018
+082 R 017 #04 bmq26 a8
+083 R 017 # mqz26 acy8
+084 R # # bq26 a018
+085 R # # 6 abcfrstuvwyz8
+086 R #01 # a26 z8
+088 R # # a6 z8
+100 NR 013 # abdflqtu26 cegjknp0148
+110 NR 012 # afltu26 bcdegknp0148
+111 NR 012 # adflqtu26 cegjknp0148
+130 NR 0123456789 # afhlort26 dgkmnps018
+210 R 01 #0 ab26 08
+222 R # 0123456789 ab6 8
+240 NR 01 0123456789 afhlor26 dgkmnps018
+242 R 01 0123456789 abchy6 np8
+243 NR 01 0123456789 afhlor6 dgkmnps8
+245 NR 01 0123456789 abcfghs6 knp8
+246 R 0123 #012345678 abfghi56 np8
+247 R 01 01 abfghx6 np8
+250 R #23 # ab36 8
+251 R # # 236 a018
+254 NR # # a6 8
+255 R # # abcdefg6 8
+256 NR # # a6 8
+257 R # # 26 a018
+258 R # # ab6 8
+260 R #23 # 36 abcefg8
+263 NR # # a6 8
+264 R #23 01234 36 abc8
+270 R #12 #07 bcdefghi6 ajklmnpqrz48
+300 R # # be36 acfg8
+306 NR # # 6 a8
+307 R #8 # ab6 8
+310 NR # # ab6 018
+321 R # # ab6 018
+334 R # # 236 ab018
+335 R # # 236 ab018
+336 R # # 236 ab018
+337 R # # 236 ab018
+338 R # # 236 ab018
+340 R # # 236 abcdefghijklmnopqr018
+341 R #01 # a236 bcde8
+342 R * * * *
+343 R * * * *
+344 R # # 236 abcdefgh018
+345 R # # 236 abd018
+346 R # # 236 ab018
+347 R # # 236 abcdef018
+348 R # # 236 abcd018
+351 R # # 36 abc8
+352 R # # 6 abcdefgiq8
+353 R # # 236 ab018
+355 R 0123458 # bcdeghj6 af8
+357 NR # # a6 bcg8
+362 R 01 # az6 8
+363 R * * * *
+365 R * * * *
+366 R * * * *
+370 R # # 236 cfgistuv018
+377 R # #7 236 al018
+380 R # # 236 a018
+381 R # # 236 auv018
+382 R #0123 #01 rst236 abdenpv018
+383 R # # e236 abcd8
+384 R #01 # a36 8
+385 R # # mn236 ab018
+386 R # # mn236 ab0148
+387 R * * * *
+388 R #12 # 236 a018
+490 R 01 # l36 avx8
+500 R # # a356 8
+501 R # # a56 8
+502 R # # abcd6 go8
+504 R # # ab6 8
+505 R 0128 #0 a6 grtu8
+506 R #01 # a2356 bcdefgqu8
+507 R # # ab6 8
+508 R # # a6 8
+510 R 01234 # abcx36 u8
+511 R 01 # a6 8
+513 R # # ab6 8
+514 R # # adefimz6 bcghjku8
+515 R # # a6 8
+516 R #8 # a6 8
+518 R # # a36 dop0128
+520 R #0123478 # abc236 u8
+521 R #012348 # b36 a8
+522 R #8 # a6 8
+524 R #8 # a236 8
+525 R # # a6 8
+526 R 08 # ai56 bcdxz8
+530 R # # abcd36 u8
+532 R 0128 # a6 8
+533 R # # adey3567 bcfmn8
+534 R # # abcelmpt36 fknoxz8
+535 R 12 # g36 abcd8
+536 R # # a6 bcdefgh8
+538 R # # ai356 u8
+540 R # # abcdq2356 fgu8
+541 R #01 # abcdef356 hno8
+542 R #01 # abcdefghijklmnopqrs6 u8
+544 R #01 # 36 abcden8
+545 R #01 # ab6 u8
+546 R # # a36 b8
+547 R # # a6 8
+550 R # # a6 8
+552 R # # abcdefghijklmn6 opu8
+555 R #08 # abcd36 u8
+556 R # # a6 z8
+561 R #01 # a356 u8
+562 R # # 356 abcde8
+563 R # # a356 u8
+565 R #08 # a36 bcde8
+567 R #8 # a26 b018
+580 R # # a6 8
+581 R #8 # a36 z8
+583 R * * * *
+584 R # # 356 ab8
+585 R # # a356 8
+586 R #8 # a36 8
+588 R #01 # a56 8
+600 R 013 01234567 abdfhloqrstu236 cegjkmnpvxyz0148
+610 R 012 01234567 afhlorstu236 bcdegkmnpvxyz0148
+611 R 012 01234567 adfhlqstu236 cegjknpvxyz0148
+630 R 0123456789 01234567 afhlort236 degkmnpsvxyz0148
+647 R # 01234567 a236 cdgvxyz018
+648 R # 01234567 a236 vxyz018
+650 R #012 01234567 abcd236 egvxyz0148
+651 R # 01234567 a236 egvxyz0148
+653 R #012 #0123456 6 a8
+654 R #012 # 236 abcevyz0148
+655 R #0 01234567 a2356 bcvxyz018
+656 R # 7 ak236 vxyz018
+657 R # 7 a236 vxyz018
+658 R # # abcd26 8
+662 R # # bd26 acefgh0148
+688 R # # a26 eg0148
+700 R 013 #2 abdfhloqrstux356 cegijkmnp0148
+710 R 012 #2 afhlorstux356 bcdegikmnp0148
+711 R 012 #2 adfhlqstux356 cegijknp0148
+720 R #12 # a6 e48
+730 R 0123456789 #2 afhlorstx356 dgikmnp0148
+740 R 0123456789 #2 ah56 np8
+751 R # # a236 eg0148
+752 R # # bc26 adefgh0148
+753 R # # abc26 018
+754 R # # 26 acdxz018
+758 R # # a356 i0148
+760 R 01 #8 abcdhmstxy67 ginow48
+762 R 01 #8 abcdhmstxy67 ginow48
+765 R 01 #8 abcdhmstuxyz67 ginkorw48
+767 R 01 #8 abcdhmstuxyz67 ginkorw48
+770 R 01 #8 abcdhmstuxyz67 ginkorw48
+772 R 01 #08 abcdhmstuxyz67 ginkorw48
+773 R 01 #8 abcdhmpqstuxy367 gikorwz48
+774 R 01 #8 abcdhmstuxy67 ginkorwz48
+775 R 01 #8 abcdefhmstuxyz67 ginkorw48
+776 R 01 #8 abcdhmstuxy67 ginkorwz48
+777 R 01 #8 abcdhmstxy67 ginkow48
+780 R 01 01234567 abcdhmstuxyz67 ginkorw48
+785 R 01 012345678 abcdhmstuxyz67 ginkorw48
+786 R 01 #8 abcdhjmpstuvxyz67 ginkorw48
+787 R 01 #8 abcdhmstuxyz67 ginkorw48
+788 R * * * *
+800 R 013 # abdfhloqrstuvx3567 cegjkmnpw0148
+810 R 012 # afhlorstuvx3567 bcdegkmnpw0148
+811 R 012 # adfhlqstuvx3567 cegjknpw0148
+830 R # 0123456789 afhlorstvx3567 dgkmnpw0148
+841 NR * * * *
+842 NR * * * *
+843 R * * * *
+844 NR * * * *
+845 R * * * *
+850 R # # - a8
+852 R * * * *
+853 R * * * *
+854 R * * * *
+855 R * * * *
+856 R #012347 #01278 hjklnopqr2367 abcdfimstuvwxyz8
+857 R * * * *
+863 R * * * *
+864 R * * * *
+865 R * * * *
+866 R * * * *
+867 R * * * *
+868 R * * * *
+876 R * * * *
+877 R * * * *
+878 R * * * *
+880 R * * * *
+881 R * * * *
+882 NR # # 6 aiw8
+883 R #01 # acdqu wx018
+884 R # # agkq u
+885 R * * * *
+886 R * * * *
+887 R # # 2 a
+`
+
+// Tags that were once defined in MARC 21 bibliographic but are now obsolete.
+var obsoleteBibliographicTags = []string{
+	"211", "212", "214", "241", "265", "301", "302", "303", "304", "305",
+	"308", "315", "350", "359", "440", "503", "512", "517", "523", "527",
+	"537", "543", "570", "582", "652", "680", "681", "683", "755",
+}
+
+// MARC 21 authority rules, same syntax as bibliographicRulesTable.
+// See https://www.loc.gov/marc/authority/
+const authorityRulesTable = `
+001 NR
+003 NR
+005 NR
+008 NR
+010 NR # # a z8
+016 R #7 # 2 az8
+020 R # # ac6 qz8
+022 R #01 # al26 myz8
+024 R 012378 # acd26 qz8
+031 R # # abcegmnopr26 dqstuyz8
+034 R 013 #01 adefgjrxyz236 bchkmnpst018
+035 R # # a6 z8
+040 NR # # abcef6 d8
+042 NR # # - a
+043 NR # # 6 abc0128
+045 NR #012 # 6 abc8
+046 R * * * *
+050 R #01 04 abd36 58
+052 R #17 # a26 bd8
+053 R #01 04 5 abc68
+055 R * * * *
+060 R #01 04 * *
+065 R * * * *
+066 NR # # ab c
+070 R * * * *
+072 R # 07 2 ax68
+073 R # # z26 a8
+080 R * * * *
+082 R * * * *
+083 R * * * *
+086 R * * * *
+087 R * * * *
+100 NR 013 # abdfhloqrt6 cegjkmnpsvxyz8
+110 NR 012 # afhlort6 bcdegkmnpsvxyz8
+111 NR 012 # adfhlqrt6 cegjkmnpsvxyz8
+130 NR # 0123456789 afhlort6 dgkmnpsvxyz8
+147 NR # # a6 cdgvxyz8
+148 NR # # a6 vxyz8
+150 NR # # ab6 gvxyz8
+151 NR # # a6 gvxyz8
+155 NR # # a6 vxyz8
+162 NR # # a6 8
+180 NR # # 6 vxyz8
+181 NR # # 6 vxyz8
+182 NR # # 6 vxyz8
+185 NR # # 6 vxyz8
+260 R # # 6 ai8
+336 R # # 236 ab018
+360 R # # 6 ai08
+367 R * * * *
+368 R * * * *
+370 R * * * *
+371 R * * * *
+372 R * * * *
+373 R * * * *
+374 R * * * *
+375 R * * * *
+376 R * * * *
+377 R * * * *
+378 R * * * *
+380 R * * * *
+381 R * * * *
+382 R * * * *
+383 R * * * *
+384 R * * * *
+385 R * * * *
+386 R * * * *
+387 R * * * *
+388 R * * * *
+400 R 013 # abdfhloqrt56 cegijkmnpsvwxyz0148
+410 R 012 # afhlort56 bcdegijkmnpsvwxyz0148
+411 R 012 # adfhlqrt56 cegijkmnpsvwxyz0148
+430 R # 0123456789 afhlort56 dgijkmnpsvwxyz0148
+447 R # # a56 cdgivwxyz0148
+448 R # # a56 ivwxyz0148
+450 R # # ab56 givwxyz0148
+451 R # # a56 givwxyz0148
+455 R # # a56 ivwxyz0148
+462 R # # a56 iw0148
+480 R # # 56 ivwxyz0148
+481 R # # 56 ivwxyz0148
+482 R # # 56 ivwxyz0148
+485 R # # 56 ivwxyz0148
+500 R 013 # abdfhloqrt56 cegijkmnpsvwxyz0148
+510 R 012 # afhlort56 bcdegijkmnpsvwxyz0148
+511 R 012 # adfhlqrt56 cegijkmnpsvwxyz0148
+530 R # 0123456789 afhlort56 dgijkmnpsvwxyz0148
+547 R # # a56 cdgivwxyz0148
+548 R # # a56 ivwxyz0148
+550 R # # ab56 givwxyz0148
+551 R # # a56 givwxyz0148
+555 R # # a56 ivwxyz0148
+562 R # # a56 iw0148
+580 R # # 56 ivwxyz0148
+581 R # # 56 ivwxyz0148
+582 R # # 56 ivwxyz0148
+585 R # # 56 ivwxyz0148
+640 R * * * *
+641 R * * * *
+642 R * * * *
+643 R * * * *
+644 R * * * *
+645 R * * * *
+646 R * * * *
+663 NR # # ab6 t8
+664 NR # # ab6 t8
+665 NR # # 6 a8
+666 NR # # 6 a8
+667 R # # a56 8
+670 R # # ab6 uw8
+672 R * * * *
+673 R * * * *
+675 R # # 6 a8
+678 R #01 # 6 abu8
+680 R # # 6 ai8
+681 R # # 6 ai8
+682 NR # # 6 ai08
+688 R # # 6 a8
+700 R * * * *
+710 R * * * *
+711 R * * * *
+730 R * * * *
+747 R * * * *
+748 R * * * *
+750 R * * * *
+751 R * * * *
+755 R * * * *
+762 R * * * *
+780 R * * * *
+781 R * * * *
+782 R * * * *
+785 R * * * *
+788 R * * * *
+856 R #012347 #01278 hjklnopqr2367 abcdfimstuvwxyz8
+880 R * * * *
+883 R #01 # acdqu wx018
+884 R # # agkq u
+885 R * * * *
+`
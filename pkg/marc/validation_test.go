@@ -0,0 +1,113 @@
+package marc
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	record := setUpTestRecord("testdata/test_1a.mrc", t)
+	got := record.Validate()
+	want := []ValidationError{{Tag: "440", Message: "obsolete tag"}}
+	if !cmp.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestValidateFields(t *testing.T) {
+	t.Parallel()
+
+	valid245 := Field{Tag: "245", Indicator1: "1", Indicator2: "0", SubFields: []SubField{{Code: "a", Value: "Title"}}}
+	valid008 := Field{Tag: "008", Value: "850101s1985    nyua          000 0 eng d"}
+
+	tests := []struct {
+		name   string
+		fields []Field
+		want   []ValidationError
+	}{
+		{
+			name:   "valid",
+			fields: []Field{valid008, valid245},
+			want:   []ValidationError{},
+		},
+		{
+			name:   "missing mandatory fields",
+			fields: []Field{{Tag: "001", Value: "123"}},
+			want: []ValidationError{
+				{Tag: "008", Message: "mandatory field is missing"},
+				{Tag: "245", Message: "mandatory field is missing"},
+			},
+		},
+		{
+			name:   "bad 008 length",
+			fields: []Field{{Tag: "008", Value: "850101"}, valid245},
+			want:   []ValidationError{{Tag: "008", Message: "must be 40 characters long, found 6"}},
+		},
+		{
+			name:   "not repeatable",
+			fields: []Field{valid008, valid245, valid245},
+			want:   []ValidationError{{Tag: "245", Message: "field is not repeatable"}},
+		},
+		{
+			name: "invalid and undefined tags",
+			fields: []Field{valid008, valid245,
+				{Tag: "24x", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a"}}},
+				{Tag: "399", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a"}}},
+				{Tag: "499", Indicator1: "x", Indicator2: "x", SubFields: []SubField{{Code: "a"}}},
+				{Tag: "302", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a"}}},
+				{Tag: "269", Indicator1: " ", Indicator2: " ", SubFields: []SubField{{Code: "a"}}},
+			},
+			want: []ValidationError{
+				{Tag: "24x", Message: "invalid tag"},
+				{Tag: "302", Message: "obsolete tag"},
+				{Tag: "269", Message: "undefined tag"},
+			},
+		},
+		{
+			name: "indicators",
+			fields: []Field{valid008,
+				{Tag: "245", Indicator1: "2", Indicator2: "", SubFields: []SubField{{Code: "a"}}},
+			},
+			want: []ValidationError{
+				{Tag: "245", Message: `invalid first indicator "2"`},
+				{Tag: "245", Message: `invalid second indicator ""`},
+			},
+		},
+		{
+			name: "subfields",
+			fields: []Field{valid008,
+				{Tag: "245", Indicator1: "1", Indicator2: "0", SubFields: []SubField{
+					{Code: "a"}, {Code: "a"}, {Code: "a"}, {Code: "n"}, {Code: "n"}, {Code: "x"}, {Code: "x"}, {Code: "$"},
+				}},
+				{Tag: "500", Indicator1: " ", Indicator2: " "},
+			},
+			want: []ValidationError{
+				{Tag: "245", Message: "subfield $a is not repeatable"},
+				{Tag: "245", Message: "subfield $x is not defined"},
+				{Tag: "245", Message: `invalid subfield code "$"`},
+				{Tag: "500", Message: "field has no subfields"},
+			},
+		},
+		{
+			name: "control field with subfields",
+			fields: []Field{valid008, valid245,
+				{Tag: "001", Indicator1: " ", SubFields: []SubField{{Code: "a"}}},
+			},
+			want: []ValidationError{{Tag: "001", Message: "control field cannot have indicators or subfields"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leader, _ := NewLeader([]byte("01234nam a2200385 a 4500"))
+			record := Record{Leader: leader, Fields: tt.fields}
+			got := record.Validate()
+			if !cmp.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}